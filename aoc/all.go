@@ -0,0 +1,115 @@
+package aoc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// RunAll solves every part of every registered day against its default
+// input, running up to jobs parts at a time (all CPUs when jobs < 1). Each
+// input is parsed once, by the first of its parts to run, and its warnings
+// printed once. Results are written in day and part order as soon as they
+// and everything before them are done, followed by the total wall time.
+func RunAll(ctx context.Context, w io.Writer, opts Options, jobs int) error {
+	out, err := NewResultWriter(w, opts.Format, TextByDay)
+	if err != nil {
+		return err
+	}
+	if jobs < 1 {
+		jobs = runtime.NumCPU()
+	}
+
+	type task struct {
+		s         Solver
+		day, part int
+		parse     func() (parsed, error)
+		result    Result
+		done      chan struct{}
+	}
+
+	var tasks []*task
+	for _, day := range Days() {
+		s, _ := Lookup(day)
+		parse := sync.OnceValues(func() (parsed, error) {
+			return parseInput(s, day, DefaultInput(day), opts)
+		})
+		for part := 1; part <= NumParts(s); part++ {
+			if opts.Part != 0 && opts.Part != part {
+				continue
+			}
+			tasks = append(tasks, &task{s: s, day: day, part: part, parse: parse, done: make(chan struct{})})
+		}
+	}
+
+	ctx = withObservers(ctx, opts)
+	start := time.Now()
+	queue := make(chan *task)
+	for range min(jobs, len(tasks)) {
+		go func() {
+			for t := range queue {
+				filename := DefaultInput(t.day)
+				in, err := t.parse()
+				if err != nil {
+					t.result = Result{Day: t.day, Part: t.part, Input: filename, Err: err.Error()}
+				} else {
+					t.result, _ = solveParsed(ctx, t.s, t.day, t.part, filename, in, opts)
+				}
+				if opts.History != "" {
+					if err := record(opts.History, []Result{t.result}); err != nil {
+						fmt.Fprintf(os.Stderr, "warning: recording run history: %v\n", err)
+					}
+				}
+				close(t.done)
+			}
+		}()
+	}
+	go func() {
+		for _, t := range tasks {
+			queue <- t
+		}
+		close(queue)
+	}()
+
+	failed := 0
+	var solving time.Duration
+	for _, t := range tasks {
+		<-t.done
+		if t.result.Err != "" {
+			failed++
+		}
+		solving += t.result.Duration
+		if err := out.Write(t.result); err != nil {
+			return err
+		}
+	}
+	if err := out.Flush(); err != nil {
+		return err
+	}
+
+	total := time.Since(start)
+	summary := fmt.Sprintf("Total: %s in %v wall time (%v solving, %s)", count(len(tasks), "part"),
+		total.Round(time.Millisecond), solving.Round(time.Millisecond), count(jobs, "job"))
+	if opts.Format == FormatText || opts.Format == "" {
+		fmt.Fprintln(w, summary)
+	} else {
+		fmt.Fprintln(os.Stderr, summary)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d parts failed", failed, len(tasks))
+	}
+	return nil
+}
+
+// count returns n followed by noun, adding an s unless n is 1.
+func count(n int, noun string) string {
+	if n != 1 {
+		noun += "s"
+	}
+	return fmt.Sprintf("%d %s", n, noun)
+}
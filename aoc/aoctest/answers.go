@@ -0,0 +1,180 @@
+// Package aoctest checks registered solvers against the golden answers
+// checked in next to each day's inputs.
+//
+// An answers file lists one input file per line followed by the expected
+// answer of each part. A "-" marks a part that is not checked for that file:
+//
+//	# file        part1  part2
+//	example.txt   3      6
+//	input.txt     1165   6496
+package aoctest
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"text/tabwriter"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+// AnswersFile is the name of the golden answers file in each day directory.
+const AnswersFile = "answers.txt"
+
+// Skip marks a part that is not checked for an input file.
+const Skip = "-"
+
+var update = flag.Bool("update", false, "rewrite answers files with the computed answers")
+
+// Answer is the expected result of every part for one input file.
+type Answer struct {
+	File  string
+	Parts [2]string
+	line  int
+}
+
+// ReadAnswers parses an answers file.
+func ReadAnswers(filename string) ([]Answer, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var answers []Answer
+	scanner := bufio.NewScanner(file)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("%s:%d: want \"file part1 part2\", got %q", filename, lineNum, line)
+		}
+
+		answers = append(answers, Answer{
+			File:  fields[0],
+			Parts: [2]string{fields[1], fields[2]},
+			line:  lineNum,
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return answers, nil
+}
+
+// WriteAnswers writes answers to filename in the format read by ReadAnswers.
+func WriteAnswers(filename string, answers []Answer) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(file, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "# file\tpart1\tpart2")
+	for _, a := range answers {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", a.File, a.Parts[0], a.Parts[1])
+	}
+	if err := tw.Flush(); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// Check runs the solver registered for day against every input listed in
+// the answers file of the current directory and reports each part whose
+// answer differs. Inputs are parsed in strict mode. The full puzzle inputs
+// are skipped in -short mode.
+//
+// With -update the answers file is rewritten from the computed answers
+// instead; parts marked "-" stay unchecked.
+func Check(t *testing.T, day int) {
+	t.Helper()
+
+	s, ok := aoc.Lookup(day)
+	if !ok {
+		t.Fatalf("no solver registered for day %d", day)
+	}
+
+	answers, err := ReadAnswers(AnswersFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range answers {
+		want := &answers[i]
+		t.Run(want.File, func(t *testing.T) {
+			if testing.Short() && !*update && want.File == "input.txt" {
+				t.Skip("skipping full puzzle input in short mode")
+			}
+
+			got, err := solveFile(s, want.File)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for part := range want.Parts {
+				if want.Parts[part] == Skip {
+					continue
+				}
+				if *update {
+					want.Parts[part] = got[part]
+					continue
+				}
+				if got[part] != want.Parts[part] {
+					t.Errorf("%s:%d: %s part %d mismatch\n  want: %s\n  got:  %s",
+						AnswersFile, want.line, want.File, part+1, want.Parts[part], got[part])
+				}
+			}
+		})
+	}
+
+	if *update {
+		if err := WriteAnswers(AnswersFile, answers); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func solveFile(s aoc.Solver, filename string) ([2]string, error) {
+	var got [2]string
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return got, err
+	}
+	defer file.Close()
+
+	input, err := s.Parse(file, &aoc.Diagnostics{File: filename, Strict: true})
+	if err != nil {
+		return got, err
+	}
+
+	for i, solve := range []func(context.Context, any) (any, error){s.Part1, s.Part2} {
+		answer, err := solve(context.Background(), input)
+		if errors.Is(err, aoc.ErrNoPart) {
+			got[i] = Skip
+			continue
+		}
+		if err != nil {
+			return got, fmt.Errorf("%s part %d: %w", filename, i+1, err)
+		}
+		got[i] = fmt.Sprint(answer)
+	}
+
+	return got, nil
+}
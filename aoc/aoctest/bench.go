@@ -0,0 +1,72 @@
+package aoctest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+// Benchmark runs the parse and part benchmarks of day against input.txt in
+// the current directory as sub-benchmarks of b.
+func Benchmark(b *testing.B, day int) {
+	s, ok := aoc.Lookup(day)
+	if !ok {
+		b.Fatalf("no solver registered for day %d", day)
+	}
+
+	data, err := os.ReadFile("input.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	benchmarks, err := Benchmarks(s, data)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.Phase, bm.F)
+	}
+}
+
+// PhaseBenchmark is a named benchmark of one phase of a day's solver.
+type PhaseBenchmark struct {
+	Phase string
+	F     func(b *testing.B)
+}
+
+// Benchmarks returns benchmarks of parsing data and solving each part of
+// the day from the parsed input. Parts the solver does not have are left out.
+func Benchmarks(s aoc.Solver, data []byte) ([]PhaseBenchmark, error) {
+	input, err := s.Parse(bytes.NewReader(data), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	benchmarks := []PhaseBenchmark{{"parse", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := s.Parse(bytes.NewReader(data), nil); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}}}
+
+	parts := []func(context.Context, any) (any, error){s.Part1, s.Part2}
+	for i, solve := range parts[:aoc.NumParts(s)] {
+		benchmarks = append(benchmarks, PhaseBenchmark{fmt.Sprintf("part%d", i+1), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := solve(context.Background(), input); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}})
+	}
+
+	return benchmarks, nil
+}
@@ -0,0 +1,308 @@
+package aoctest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+// DiffTimeout bounds every part solved during a differential test, so an
+// input that sends either solver into a long search shows up as a failure
+// instead of a hung test.
+const DiffTimeout = 10 * time.Second
+
+// Inputs describes the generated inputs a differential test compares the
+// solvers on. They should be small enough for a brute-force reference.
+type Inputs struct {
+	// Size is the generator size of every input.
+	Size int
+	// Params overrides generator parameters, typically to keep numbers
+	// small.
+	Params aoc.Params
+	// Seeds is how many inputs to try: 100 when 0, and a tenth of that in
+	// -short mode.
+	Seeds int
+}
+
+// Differential compares the solver registered for day with ref, an
+// independent and deliberately naive reference implementation, on inputs
+// from the day's generator. The first input they disagree on is shrunk to
+// a minimal one that still shows the disagreement, and reported.
+//
+// A reference returns an error for inputs outside the puzzle's promises,
+// such as a polygon that is not simple. Generated inputs must never be
+// rejected that way, but candidates tried while shrinking are dropped.
+func Differential(t *testing.T, day int, ref aoc.Solver, in Inputs) {
+	t.Helper()
+
+	s, ok := aoc.Lookup(day)
+	if !ok {
+		t.Fatalf("no solver registered for day %d", day)
+	}
+	g, ok := aoc.LookupGenerator(day)
+	if !ok {
+		t.Fatalf("no input generator registered for day %d", day)
+	}
+
+	seeds := in.Seeds
+	if seeds == 0 {
+		seeds = 100
+	}
+	if testing.Short() {
+		seeds = max(seeds/10, 1)
+	}
+
+	for seed := range uint64(seeds) {
+		var buf bytes.Buffer
+		if err := g.Generate(&buf, seed, in.Size, in.Params); err != nil {
+			t.Fatalf("seed %d: %v", seed, err)
+		}
+
+		d, err := compare(s, ref, buf.Bytes())
+		if err != nil {
+			t.Fatalf("seed %d: %v\n%s", seed, err, buf.Bytes())
+		}
+		if d == nil {
+			continue
+		}
+
+		small := Minimize(buf.Bytes(), func(data []byte) bool {
+			d, err := compare(s, ref, data)
+			return err == nil && d != nil
+		})
+		d, _ = compare(s, ref, small)
+		t.Fatalf("seed %d: %v\nreproduce with: aoc gen --day %d --seed %d%s\nminimized input:\n%s",
+			seed, d, day, seed, genFlags(in), small)
+	}
+}
+
+// disagreement is a part the solver and the reference answer differently.
+type disagreement struct {
+	part      int
+	got, want string
+}
+
+func (d *disagreement) Error() string {
+	return fmt.Sprintf("part %d: solver says %s, reference says %s", d.part, d.got, d.want)
+}
+
+// compare solves data with s and ref and returns the first part they
+// disagree on, or nil. The error reports data the reference or a strict
+// parse rejects.
+func compare(s, ref aoc.Solver, data []byte) (*disagreement, error) {
+	refInput, err := ref.Parse(bytes.NewReader(data), &aoc.Diagnostics{File: "reference", Strict: true})
+	if err != nil {
+		return nil, err
+	}
+	input, err := s.Parse(bytes.NewReader(data), &aoc.Diagnostics{File: "generated", Strict: true})
+	if err != nil {
+		return nil, err
+	}
+
+	refParts := []func(context.Context, any) (any, error){ref.Part1, ref.Part2}
+	parts := []func(context.Context, any) (any, error){s.Part1, s.Part2}
+	for i := range parts {
+		want, err := answer(refParts[i], refInput)
+		if errors.Is(err, aoc.ErrNoPart) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reference part %d: %w", i+1, err)
+		}
+
+		got, err := answer(parts[i], input)
+		if err != nil {
+			got = "error: " + err.Error()
+		}
+		if got != want {
+			return &disagreement{part: i + 1, got: got, want: want}, nil
+		}
+	}
+	return nil, nil
+}
+
+// answer solves one part under DiffTimeout, turning a panic into an error.
+func answer(solve func(context.Context, any) (any, error), input any) (got string, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DiffTimeout)
+	defer cancel()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	a, err := solve(ctx, input)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprint(a), nil
+}
+
+// genFlags returns the aoc gen flags that reproduce the inputs of in
+// besides the seed.
+func genFlags(in Inputs) string {
+	var b strings.Builder
+	if in.Size != 0 {
+		fmt.Fprintf(&b, " --size %d", in.Size)
+	}
+	names := make([]string, 0, len(in.Params))
+	for name := range in.Params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, " --set %s=%d", name, in.Params[name])
+	}
+	return b.String()
+}
+
+// Minimize shrinks data while fails keeps reporting true for it by
+// dropping runs of lines, halving the run length down to single lines,
+// dropping single comma or space separated fields within a line, and
+// lowering every number as far as it will go. The passes repeat until none
+// of them makes progress. Minimize assumes fails(data) is true and returns
+// the smallest input found.
+func Minimize(data []byte, fails func([]byte) bool) []byte {
+	join := func(lines []string) []byte {
+		return []byte(strings.Join(lines, "\n") + "\n")
+	}
+	keep := func(lines []string) bool { return fails(join(lines)) }
+
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	for {
+		before := string(join(lines))
+		lines = dropLines(lines, keep)
+		lines = dropFields(lines, keep)
+		lines = lowerNumbers(lines, keep)
+		if string(join(lines)) == before {
+			return join(lines)
+		}
+	}
+}
+
+// dropLines removes runs of lines as long as keep accepts what is left.
+func dropLines(lines []string, keep func([]string) bool) []string {
+	for n := len(lines) / 2; n >= 1; {
+		removed := false
+		for i := 0; i+n <= len(lines); {
+			candidate := append(lines[:i:i], lines[i+n:]...)
+			if keep(candidate) {
+				lines = candidate
+				removed = true
+				continue
+			}
+			i += n
+		}
+		// Single lines are retried until none can go, as removing one can
+		// make another removable.
+		if n > 1 || !removed {
+			n /= 2
+		}
+	}
+	return lines
+}
+
+// dropFields removes single fields of every line as long as keep accepts
+// the result.
+func dropFields(lines []string, keep func([]string) bool) []string {
+	for i := range lines {
+		for j := 0; ; {
+			spans := fieldSpans(lines[i])
+			if j >= len(spans) {
+				break
+			}
+			candidate := slices.Clone(lines)
+			candidate[i] = lines[i][:spans[j][0]] + lines[i][spans[j][1]:]
+			if keep(candidate) {
+				lines = candidate
+				continue
+			}
+			j++
+		}
+	}
+	return lines
+}
+
+// lowerNumbers lowers every number of every line as long as keep accepts
+// the result, trying the largest decrease first.
+func lowerNumbers(lines []string, keep func([]string) bool) []string {
+	for i := range lines {
+		for j := 0; ; j++ {
+			spans := numberSpans(lines[i])
+			if j >= len(spans) {
+				break
+			}
+			start, end := spans[j][0], spans[j][1]
+			n, err := strconv.Atoi(lines[i][start:end])
+			if err != nil {
+				continue
+			}
+			for step := n; step > 0; {
+				candidate := slices.Clone(lines)
+				candidate[i] = lines[i][:start] + strconv.Itoa(n-step) + lines[i][end:]
+				if !keep(candidate) {
+					step /= 2
+					continue
+				}
+				lines = candidate
+				n -= step
+				end = start + len(strconv.Itoa(n))
+				step = min(step, n)
+			}
+		}
+	}
+	return lines
+}
+
+// fieldSpans returns the [start, end) span of every field of line, each
+// taking one adjacent separator along so removing it leaves the others
+// separated.
+func fieldSpans(line string) [][2]int {
+	isSep := func(c byte) bool { return c == ',' || c == ' ' }
+
+	var spans [][2]int
+	for i := 0; i < len(line); {
+		if isSep(line[i]) {
+			i++
+			continue
+		}
+		start := i
+		for i < len(line) && !isSep(line[i]) {
+			i++
+		}
+		end := i
+		if end < len(line) {
+			end++
+		} else if start > 0 {
+			start--
+		}
+		spans = append(spans, [2]int{start, end})
+	}
+	return spans
+}
+
+// numberSpans returns the [start, end) span of every run of digits in line.
+func numberSpans(line string) [][2]int {
+	var spans [][2]int
+	for i := 0; i < len(line); {
+		if line[i] < '0' || line[i] > '9' {
+			i++
+			continue
+		}
+		start := i
+		for i < len(line) && line[i] >= '0' && line[i] <= '9' {
+			i++
+		}
+		spans = append(spans, [2]int{start, i})
+	}
+	return spans
+}
@@ -0,0 +1,89 @@
+package aoctest
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+// Fuzz fuzzes the parser of day, seeded with the example inputs in the
+// current directory. Besides not panicking, the parser must accept any
+// input in lenient mode, report every problem it skips as a warning, and
+// fail in strict mode with a *aoc.ParseError exactly when it warns in
+// lenient mode.
+func Fuzz(f *testing.F, day int) {
+	s, ok := aoc.Lookup(day)
+	if !ok {
+		f.Fatalf("no solver registered for day %d", day)
+	}
+
+	examples, err := filepath.Glob("example*.txt")
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, name := range examples {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(data)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		lenient := &aoc.Diagnostics{File: "fuzz"}
+		_, err := s.Parse(bytes.NewReader(data), lenient)
+		if errors.Is(err, bufio.ErrTooLong) {
+			t.Skip("line longer than the scanner accepts")
+		}
+		if err != nil {
+			t.Fatalf("lenient parse failed: %v", err)
+		}
+
+		strict := &aoc.Diagnostics{File: "fuzz", Strict: true}
+		_, err = s.Parse(bytes.NewReader(data), strict)
+		var perr *aoc.ParseError
+		switch {
+		case err != nil && !errors.As(err, &perr):
+			t.Fatalf("strict parse failed without a *aoc.ParseError: %v", err)
+		case err != nil && len(lenient.Warnings) == 0:
+			t.Fatalf("strict parse failed with %v but lenient parse had no warnings", err)
+		case err == nil && len(lenient.Warnings) > 0:
+			t.Fatalf("strict parse succeeded but lenient parse warned: %v", lenient.Warnings[0])
+		}
+	})
+}
+
+// FuzzLine fuzzes a parser of single input lines, seeded with every
+// non-empty line of the named example file. The parser must not panic and
+// must fail with a *aoc.ParseError whose column lies within the line.
+func FuzzLine[T any](f *testing.F, example string, parse func(line string) (T, error)) {
+	data, err := os.ReadFile(example)
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			f.Add(line)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, line string) {
+		_, err := parse(line)
+		if err == nil {
+			return
+		}
+		var perr *aoc.ParseError
+		if !errors.As(err, &perr) {
+			t.Fatalf("parse(%q) failed without a *aoc.ParseError: %v", line, err)
+		}
+		if perr.Column < 0 || perr.Column > len(line)+1 {
+			t.Fatalf("parse(%q) reported column %d outside the line", line, perr.Column)
+		}
+	})
+}
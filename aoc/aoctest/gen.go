@@ -0,0 +1,44 @@
+package aoctest
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+// CheckGenerator checks that the input generator of day produces inputs
+// the day's parser accepts in strict mode, and the same input for the same
+// seed.
+func CheckGenerator(t *testing.T, day int) {
+	t.Helper()
+
+	s, ok := aoc.Lookup(day)
+	if !ok {
+		t.Fatalf("no solver registered for day %d", day)
+	}
+	g, ok := aoc.LookupGenerator(day)
+	if !ok {
+		t.Fatalf("no input generator registered for day %d", day)
+	}
+
+	for _, size := range []int{1, 2, 10, g.DefaultSize} {
+		for seed := range uint64(5) {
+			var a, b bytes.Buffer
+			if err := g.Generate(&a, seed, size, nil); err != nil {
+				t.Fatalf("size %d seed %d: %v", size, seed, err)
+			}
+			if err := g.Generate(&b, seed, size, nil); err != nil {
+				t.Fatalf("size %d seed %d: %v", size, seed, err)
+			}
+			if !bytes.Equal(a.Bytes(), b.Bytes()) {
+				t.Fatalf("size %d seed %d: two inputs from the same seed differ", size, seed)
+			}
+
+			diag := &aoc.Diagnostics{File: "generated", Strict: true}
+			if _, err := s.Parse(bytes.NewReader(a.Bytes()), diag); err != nil {
+				t.Fatalf("size %d seed %d: generated input does not parse: %v", size, seed, err)
+			}
+		}
+	}
+}
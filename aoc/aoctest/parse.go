@@ -0,0 +1,28 @@
+package aoctest
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+// StrictParseError parses input with the parser of day in strict mode and
+// returns the *aoc.ParseError it fails with, failing the test if it
+// succeeds or fails with another error.
+func StrictParseError(t *testing.T, day int, input string) *aoc.ParseError {
+	t.Helper()
+
+	s, ok := aoc.Lookup(day)
+	if !ok {
+		t.Fatalf("no solver registered for day %d", day)
+	}
+
+	_, err := s.Parse(strings.NewReader(input), &aoc.Diagnostics{File: "input", Strict: true})
+	var perr *aoc.ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf("strict parse of %q: got %v, want a *aoc.ParseError", input, err)
+	}
+	return perr
+}
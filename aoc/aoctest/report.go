@@ -0,0 +1,148 @@
+package aoctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+// BenchResult is the measurement of one benchmark.
+type BenchResult struct {
+	Day         int    `json:"day"`
+	Phase       string `json:"phase"`
+	N           int    `json:"n"`
+	NsPerOp     int64  `json:"ns_per_op"`
+	AllocsPerOp int64  `json:"allocs_per_op"`
+	BytesPerOp  int64  `json:"bytes_per_op"`
+}
+
+// BenchReport is a set of benchmark results along with where they ran.
+type BenchReport struct {
+	Date      time.Time     `json:"date"`
+	GoVersion string        `json:"go_version"`
+	GOOS      string        `json:"goos"`
+	GOARCH    string        `json:"goarch"`
+	Results   []BenchResult `json:"results"`
+}
+
+// NewBenchReport returns an empty report for the current environment.
+func NewBenchReport() *BenchReport {
+	return &BenchReport{
+		Date:      time.Now().UTC(),
+		GoVersion: runtime.Version(),
+		GOOS:      runtime.GOOS,
+		GOARCH:    runtime.GOARCH,
+	}
+}
+
+// Bench runs every benchmark of day against the input file and adds the
+// results to the report.
+func (r *BenchReport) Bench(day int, filename string) error {
+	s, ok := aoc.Lookup(day)
+	if !ok {
+		return fmt.Errorf("no solver registered for day %d", day)
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	benchmarks, err := Benchmarks(s, data)
+	if err != nil {
+		return fmt.Errorf("parsing day %d input: %w", day, err)
+	}
+
+	for _, bm := range benchmarks {
+		res := testing.Benchmark(bm.F)
+		if res.N == 0 {
+			return fmt.Errorf("day %d %s benchmark failed", day, bm.Phase)
+		}
+		r.Results = append(r.Results, BenchResult{
+			Day:         day,
+			Phase:       bm.Phase,
+			N:           res.N,
+			NsPerOp:     res.NsPerOp(),
+			AllocsPerOp: res.AllocsPerOp(),
+			BytesPerOp:  res.AllocedBytesPerOp(),
+		})
+	}
+
+	return nil
+}
+
+// ReadBenchReport loads a report written by WriteJSON.
+func ReadBenchReport(filename string) (*BenchReport, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var r BenchReport
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return &r, nil
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *BenchReport) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteTable writes the report as an aligned table. When base is not nil,
+// each result is compared with the matching result of base.
+func (r *BenchReport) WriteTable(w io.Writer, base *BenchReport) error {
+	type key struct {
+		day   int
+		phase string
+	}
+	previous := make(map[key]BenchResult)
+	if base != nil {
+		for _, res := range base.Results {
+			previous[key{res.Day, res.Phase}] = res
+		}
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	header := "DAY\tPHASE\tN\tTIME/OP\tNS/OP\tB/OP\tALLOCS/OP\t"
+	if base != nil {
+		header += "Δ NS/OP\tΔ B/OP\tΔ ALLOCS/OP\t"
+	}
+	fmt.Fprintln(tw, header)
+
+	for _, res := range r.Results {
+		fmt.Fprintf(tw, "%d\t%s\t%d\t%s\t%d\t%d\t%d\t", res.Day, res.Phase, res.N,
+			time.Duration(res.NsPerOp), res.NsPerOp, res.BytesPerOp, res.AllocsPerOp)
+		if base != nil {
+			if old, ok := previous[key{res.Day, res.Phase}]; ok {
+				fmt.Fprintf(tw, "%s\t%s\t%s\t", delta(old.NsPerOp, res.NsPerOp),
+					delta(old.BytesPerOp, res.BytesPerOp), delta(old.AllocsPerOp, res.AllocsPerOp))
+			} else {
+				fmt.Fprint(tw, "new\tnew\tnew\t")
+			}
+		}
+		fmt.Fprintln(tw)
+	}
+
+	return tw.Flush()
+}
+
+func delta(old, cur int64) string {
+	if old == 0 {
+		if cur == 0 {
+			return "~"
+		}
+		return "+inf"
+	}
+	return fmt.Sprintf("%+.1f%%", float64(cur-old)/float64(old)*100)
+}
@@ -0,0 +1,42 @@
+// Package cache locates the directories the aoc command keeps its data in.
+package cache
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// RepoDirName is the name of the cache directory at the repository root.
+const RepoDirName = ".cache"
+
+// UserDir returns the per-user cache directory, shared by every checkout,
+// which holds what belongs to the user rather than the code: downloaded
+// inputs and the answer log.
+func UserDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "advent-of-code", "2025"), nil
+}
+
+// RepoDir returns the cache directory of the repository holding the
+// working directory, found next to the nearest go.mod above it, which
+// holds what belongs to the checkout: the run history.
+func RepoDir() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return filepath.Join(dir, RepoDirName), nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", errors.New("no go.mod in the working directory or above it")
+		}
+		dir = parent
+	}
+}
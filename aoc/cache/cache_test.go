@@ -0,0 +1,35 @@
+package cache_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc/cache"
+)
+
+func TestRepoDir(t *testing.T) {
+	root, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	sub := filepath.Join(root, "day08", "cmd", "day08")
+	if err := os.MkdirAll(sub, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	want := filepath.Join(root, cache.RepoDirName)
+	for _, dir := range []string{root, sub} {
+		t.Chdir(dir)
+		got, err := cache.RepoDir()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("RepoDir from %s = %s, want %s", dir, got, want)
+		}
+	}
+}
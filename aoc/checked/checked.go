@@ -0,0 +1,138 @@
+// Package checked provides integer arithmetic that notices overflow, for
+// answers that may outgrow an int on large generated inputs.
+package checked
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// ErrOverflow is returned, usually wrapped, for a result that does not fit
+// in an int.
+var ErrOverflow = errors.New("integer overflow")
+
+// Add returns a+b and whether the sum fits in an int.
+func Add(a, b int) (int, bool) {
+	s := a + b
+	if (a > 0 && b > 0 && s < 0) || (a < 0 && b < 0 && s >= 0) {
+		return 0, false
+	}
+	return s, true
+}
+
+// Mul returns a*b and whether the product fits in an int.
+func Mul(a, b int) (int, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	p := a * b
+	if p/b != a || (a == -1 && b == math.MinInt) || (b == -1 && a == math.MinInt) {
+		return 0, false
+	}
+	return p, true
+}
+
+// Int is an integer that is kept in an int for as long as it fits and in a
+// big.Int beyond that, so sums and products never silently wrap. The zero
+// value is 0. Ints are values: the methods return new Ints and never
+// modify their operands.
+type Int struct {
+	n int
+	// b holds the value once it no longer fits in n.
+	b *big.Int
+}
+
+// New returns n as an Int.
+func New(n int) Int {
+	return Int{n: n}
+}
+
+// Parse returns the decimal integer s as an Int, however many digits it
+// has.
+func Parse(s string) (Int, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return Int{n: n}, nil
+	}
+	b, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return Int{}, fmt.Errorf("invalid integer %q", s)
+	}
+	return fromBig(b), nil
+}
+
+// Add returns x+y.
+func (x Int) Add(y Int) Int {
+	if x.b == nil && y.b == nil {
+		if s, ok := Add(x.n, y.n); ok {
+			return Int{n: s}
+		}
+	}
+	return fromBig(new(big.Int).Add(x.Big(), y.Big()))
+}
+
+// Mul returns x*y.
+func (x Int) Mul(y Int) Int {
+	if x.b == nil && y.b == nil {
+		if p, ok := Mul(x.n, y.n); ok {
+			return Int{n: p}
+		}
+	}
+	return fromBig(new(big.Int).Mul(x.Big(), y.Big()))
+}
+
+// fromBig returns b as an Int, back in an int if it fits.
+func fromBig(b *big.Int) Int {
+	if b.IsInt64() && int64(int(b.Int64())) == b.Int64() {
+		return Int{n: int(b.Int64())}
+	}
+	return Int{b: b}
+}
+
+// Cmp compares x and y and returns -1, 0 or +1 as x is less than, equal to
+// or greater than y.
+func (x Int) Cmp(y Int) int {
+	if x.b == nil && y.b == nil {
+		switch {
+		case x.n < y.n:
+			return -1
+		case x.n > y.n:
+			return 1
+		}
+		return 0
+	}
+	return x.Big().Cmp(y.Big())
+}
+
+// IsInt reports whether x fits in an int.
+func (x Int) IsInt() bool {
+	return x.b == nil
+}
+
+// Int returns x as an int, or false if it does not fit.
+func (x Int) Int() (int, bool) {
+	return x.n, x.b == nil
+}
+
+// Big returns x as a big.Int, which must not be modified.
+func (x Int) Big() *big.Int {
+	if x.b != nil {
+		return x.b
+	}
+	return big.NewInt(int64(x.n))
+}
+
+// String returns x in decimal.
+func (x Int) String() string {
+	if x.b != nil {
+		return x.b.String()
+	}
+	return strconv.Itoa(x.n)
+}
+
+// MarshalJSON writes x as a JSON number.
+func (x Int) MarshalJSON() ([]byte, error) {
+	return []byte(x.String()), nil
+}
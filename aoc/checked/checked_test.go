@@ -0,0 +1,68 @@
+package checked_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc/checked"
+)
+
+func TestAddMul(t *testing.T) {
+	tests := []struct {
+		a, b          int
+		sum, product  int
+		sumOK, prodOK bool
+	}{
+		{2, 3, 5, 6, true, true},
+		{-2, 3, 1, -6, true, true},
+		{0, math.MinInt, math.MinInt, 0, true, true},
+		{math.MaxInt, 1, 0, math.MaxInt, false, true},
+		{math.MinInt, -1, 0, 0, false, false},
+		{-1, math.MinInt, 0, 0, false, false},
+		{math.MaxInt / 2, 2, math.MaxInt/2 + 2, math.MaxInt - 1, true, true},
+		{math.MaxInt/2 + 1, 2, math.MaxInt/2 + 3, 0, true, false},
+		{1 << 32, 1 << 31, 1<<32 + 1<<31, 0, true, false},
+		{-(1 << 31), 1 << 32, -(1 << 31) + 1<<32, math.MinInt, true, true},
+		{1 << 31, 1 << 32, 1<<31 + 1<<32, 0, true, false},
+	}
+	for _, tt := range tests {
+		if got, ok := checked.Add(tt.a, tt.b); got != tt.sum || ok != tt.sumOK {
+			t.Errorf("Add(%d, %d) = %d, %v, want %d, %v", tt.a, tt.b, got, ok, tt.sum, tt.sumOK)
+		}
+		if got, ok := checked.Mul(tt.a, tt.b); got != tt.product || ok != tt.prodOK {
+			t.Errorf("Mul(%d, %d) = %d, %v, want %d, %v", tt.a, tt.b, got, ok, tt.product, tt.prodOK)
+		}
+	}
+}
+
+func TestInt(t *testing.T) {
+	x := checked.New(math.MaxInt).Add(checked.New(1))
+	if x.IsInt() || x.String() != "9223372036854775808" {
+		t.Errorf("MaxInt+1 = %v, fits %v", x, x.IsInt())
+	}
+
+	// 3^60 no longer fits, 3^40 still does.
+	power := checked.New(1)
+	for range 60 {
+		power = power.Mul(checked.New(3))
+	}
+	if got, want := power.String(), "42391158275216203514294433201"; got != want {
+		t.Errorf("3^60 = %s, want %s", got, want)
+	}
+	if power.Cmp(x) != 1 || x.Cmp(power) != -1 || power.Cmp(power) != 0 {
+		t.Errorf("comparisons of 3^60 and MaxInt+1 are wrong")
+	}
+
+	// Values that shrink back into an int are kept in one again.
+	back := x.Add(checked.New(-2))
+	if n, ok := back.Int(); !ok || n != math.MaxInt-1 {
+		t.Errorf("MaxInt+1-2 = %v, %v, want %d", n, ok, math.MaxInt-1)
+	}
+	if n, ok := checked.New(12).Mul(checked.New(-3)).Int(); !ok || n != -36 {
+		t.Errorf("12*-3 = %d, %v", n, ok)
+	}
+	var zero checked.Int
+	if zero.String() != "0" || zero.Cmp(checked.New(0)) != 0 {
+		t.Errorf("zero Int is %v", zero)
+	}
+}
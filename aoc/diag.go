@@ -0,0 +1,124 @@
+package aoc
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// ParseError describes a problem found in a puzzle input.
+type ParseError struct {
+	File   string // input name, empty if unknown
+	Line   int    // 1-based line number, 0 if unknown
+	Column int    // 1-based byte column, 0 if unknown
+	Text   string // the offending text
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	pos := e.File
+	if pos == "" {
+		pos = "input"
+	}
+	if e.Line > 0 {
+		pos += fmt.Sprintf(":%d", e.Line)
+		if e.Column > 0 {
+			pos += fmt.Sprintf(":%d", e.Column)
+		}
+	}
+	if e.Text == "" {
+		return fmt.Sprintf("%s: %v", pos, e.Err)
+	}
+	return fmt.Sprintf("%s: %v: %q", pos, e.Err, e.Text)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Diagnostics collects the problems found while parsing an input. In strict
+// mode the first problem is returned as an error and parsing stops; otherwise
+// problems are kept as warnings and the offending data is skipped.
+//
+// A nil *Diagnostics is lenient and discards every warning.
+type Diagnostics struct {
+	File     string
+	Strict   bool
+	Warnings []*ParseError
+}
+
+// Report records a problem. It returns e in strict mode and nil otherwise,
+// so parsers can stop on a non-nil result and skip the bad data on nil.
+func (d *Diagnostics) Report(e *ParseError) error {
+	if d == nil {
+		return nil
+	}
+	if e.File == "" {
+		e.File = d.File
+	}
+	if d.Strict {
+		return e
+	}
+	d.Warnings = append(d.Warnings, e)
+	return nil
+}
+
+// Errorf reports a problem at line and column of the input, see Report.
+func (d *Diagnostics) Errorf(line, column int, text, format string, args ...any) error {
+	return d.Report(&ParseError{
+		Line:   line,
+		Column: column,
+		Text:   text,
+		Err:    fmt.Errorf(format, args...),
+	})
+}
+
+// partWarnings collects the warnings of the part being solved.
+type partWarnings struct {
+	mu   sync.Mutex
+	list []string
+}
+
+type warningsKey struct{}
+
+// Warnf reports a problem a solver worked around, such as data it had to
+// leave out of the answer. The runner prints it to stderr and adds it to
+// the diagnostics of the part's result. Outside the runner it does nothing.
+func Warnf(ctx context.Context, format string, args ...any) {
+	w, ok := ctx.Value(warningsKey{}).(*partWarnings)
+	if !ok {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.list = append(w.list, fmt.Sprintf(format, args...))
+}
+
+func (w *partWarnings) warnings() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.list
+}
+
+// LineScanner is a bufio.Scanner over lines that tracks the current line
+// number for diagnostics.
+type LineScanner struct {
+	*bufio.Scanner
+	Line int
+}
+
+// NewLineScanner returns a LineScanner reading from r.
+func NewLineScanner(r io.Reader) *LineScanner {
+	return &LineScanner{Scanner: bufio.NewScanner(r)}
+}
+
+// Scan advances to the next line, see bufio.Scanner.Scan.
+func (s *LineScanner) Scan() bool {
+	if !s.Scanner.Scan() {
+		return false
+	}
+	s.Line++
+	return true
+}
@@ -0,0 +1,149 @@
+package aoc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Tracer receives the steps solvers take towards an answer when they run
+// in explain mode, to see the intermediate state behind a wrong answer.
+// Implementations must be safe for concurrent use.
+type Tracer interface {
+	Trace(s Step)
+}
+
+// Step is one step of a solver: what happened, with alternating keys and
+// values describing it, like "rotation", "L68", "dial", 82.
+type Step struct {
+	Day  int
+	Part int
+	Name string
+	Args []any
+}
+
+// String formats s as the text tracer writes it, such as
+// "Day 1 Part 1: rotation rotation=L68 dial=82".
+func (s Step) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Day %d Part %d: %s", s.Day, s.Part, s.Name)
+	for i := 0; i < len(s.Args); i += 2 {
+		value := "!MISSING"
+		if i+1 < len(s.Args) {
+			value = fmt.Sprint(s.Args[i+1])
+		}
+		if strings.ContainsAny(value, " \"=") || value == "" {
+			value = strconv.Quote(value)
+		}
+		fmt.Fprintf(&b, " %v=%s", s.Args[i], value)
+	}
+	return b.String()
+}
+
+// MarshalJSON writes s as a flat object holding the day, part and step
+// name followed by its arguments. Values with a String method are written
+// as that string unless they marshal themselves.
+func (s Step) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	name, _ := json.Marshal(s.Name)
+	fmt.Fprintf(&b, `{"day":%d,"part":%d,"step":%s`, s.Day, s.Part, name)
+	for i := 0; i < len(s.Args); i += 2 {
+		var value any = "!MISSING"
+		if i+1 < len(s.Args) {
+			value = s.Args[i+1]
+		}
+		if str, ok := value.(fmt.Stringer); ok {
+			if _, ok := value.(json.Marshaler); !ok {
+				value = str.String()
+			}
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			data, _ = json.Marshal(fmt.Sprint(value))
+		}
+		key, _ := json.Marshal(fmt.Sprint(s.Args[i]))
+		fmt.Fprintf(&b, ",%s:%s", key, data)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// NewTracer returns a tracer writing every step to w, one per line, in
+// format: text or json.
+func NewTracer(w io.Writer, format string) (Tracer, error) {
+	switch format {
+	case FormatText:
+		return &textTracer{w: w}, nil
+	case FormatJSON:
+		return &jsonTracer{enc: json.NewEncoder(w)}, nil
+	}
+	return nil, fmt.Errorf("unknown trace format %q", format)
+}
+
+type textTracer struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (t *textTracer) Trace(s Step) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	fmt.Fprintln(t.w, s)
+}
+
+type jsonTracer struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+func (t *jsonTracer) Trace(s Step) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.enc.Encode(s)
+}
+
+type tracerKey struct{}
+
+// WithTracer returns a copy of ctx that explains the solvers run with it
+// to t.
+func WithTracer(ctx context.Context, t Tracer) context.Context {
+	return context.WithValue(ctx, tracerKey{}, t)
+}
+
+// StepTracer is a solver's handle on the tracer of the part it solves.
+type StepTracer struct {
+	t         Tracer
+	day, part int
+}
+
+// Tracing returns the StepTracer for the part solved under ctx, or nil
+// when ctx carries no Tracer. Solvers look it up once and guard every step
+// with a nil check, so explain mode costs nothing when it is off:
+//
+//	tr := aoc.Tracing(ctx)
+//	...
+//	if tr != nil {
+//		tr.Step("rotation", "rotation", rot, "dial", position)
+//	}
+func Tracing(ctx context.Context) *StepTracer {
+	t, ok := ctx.Value(tracerKey{}).(Tracer)
+	if !ok || t == nil {
+		return nil
+	}
+	day, part := partOf(ctx)
+	return &StepTracer{t: t, day: day, part: part}
+}
+
+// Step traces a step called name, described by alternating keys and
+// values. It does nothing on a nil StepTracer.
+func (st *StepTracer) Step(name string, args ...any) {
+	if st == nil {
+		return
+	}
+	st.t.Trace(Step{Day: st.day, Part: st.part, Name: name, Args: args})
+}
@@ -0,0 +1,116 @@
+package aoc
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"sort"
+	"strings"
+)
+
+// Generator produces random but valid puzzle inputs of a day, to stress
+// and scale test its solver beyond the single puzzle input.
+type Generator struct {
+	// Size says what the size of an input counts, e.g. "rotations".
+	Size string
+	// DefaultSize is the size of an input when none is asked for.
+	DefaultSize int
+	// Params are the day's other knobs shaping the input.
+	Params []Param
+	// Func writes an input of the given size to w. Params holds every
+	// parameter, set to its default unless overridden.
+	Func func(w io.Writer, rng *rand.Rand, size int, params Params) error
+}
+
+// Param is a knob of a Generator.
+type Param struct {
+	Name    string
+	Default int
+	Usage   string
+}
+
+// Params maps parameter names to their values.
+type Params map[string]int
+
+var generators = make(map[int]Generator)
+
+// RegisterGenerator makes an input generator available for day. Like
+// Register it is meant to be called from an init function and panics if
+// the day already has one.
+func RegisterGenerator(day int, g Generator) {
+	if g.Func == nil {
+		panic(fmt.Sprintf("aoc: RegisterGenerator for day %d has no Func", day))
+	}
+	if _, dup := generators[day]; dup {
+		panic(fmt.Sprintf("aoc: RegisterGenerator called twice for day %d", day))
+	}
+	generators[day] = g
+}
+
+// LookupGenerator returns the input generator registered for day.
+func LookupGenerator(day int) (Generator, bool) {
+	g, ok := generators[day]
+	return g, ok
+}
+
+// GeneratorDays returns the days with an input generator in ascending
+// order.
+func GeneratorDays() []int {
+	days := make([]int, 0, len(generators))
+	for day := range generators {
+		days = append(days, day)
+	}
+	sort.Ints(days)
+	return days
+}
+
+// Generate writes an input of the given size to w, or of DefaultSize when
+// size is 0. The same seed, size and parameters always produce the same
+// input. Parameters not in set keep their defaults; unknown ones are an
+// error.
+func (g Generator) Generate(w io.Writer, seed uint64, size int, set Params) error {
+	params := make(Params, len(g.Params))
+	for _, p := range g.Params {
+		params[p.Name] = p.Default
+	}
+	for name, value := range set {
+		if _, ok := params[name]; !ok {
+			return fmt.Errorf("unknown parameter %q, want one of %s", name, strings.Join(g.ParamNames(), ", "))
+		}
+		params[name] = value
+	}
+	if size == 0 {
+		size = g.DefaultSize
+	}
+	if size < 1 {
+		return fmt.Errorf("invalid size %d", size)
+	}
+
+	bw := bufio.NewWriter(w)
+	rng := rand.New(rand.NewPCG(seed, seed^0x5DEECE66D))
+	if err := g.Func(bw, rng, size, params); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// ParamNames returns the names of the parameters of g.
+func (g Generator) ParamNames() []string {
+	names := make([]string, len(g.Params))
+	for i, p := range g.Params {
+		names[i] = p.Name
+	}
+	return names
+}
+
+// Positive returns an error naming the first of the given parameters that
+// is not positive, for generators to validate their knobs.
+func (p Params) Positive(names ...string) error {
+	for _, name := range names {
+		if p[name] < 1 {
+			return fmt.Errorf("parameter %s must be positive, got %d", name, p[name])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+// Package geom provides integer geometry in two and three dimensions:
+// points and their distances, axis-aligned rectangles and boxes, line
+// segments and polygons.
+//
+// All arithmetic is exact integer arithmetic. Rectangles and boxes are
+// closed: they include the points on their boundary, so a Rect from (0,0)
+// to (2,1) covers 3 by 2 grid cells.
+package geom
+
+import "fmt"
+
+// Point is a point in the plane.
+type Point struct {
+	X, Y int
+}
+
+// Add returns p+q.
+func (p Point) Add(q Point) Point {
+	return Point{p.X + q.X, p.Y + q.Y}
+}
+
+// Sub returns p-q.
+func (p Point) Sub(q Point) Point {
+	return Point{p.X - q.X, p.Y - q.Y}
+}
+
+func (p Point) String() string {
+	return fmt.Sprintf("(%d,%d)", p.X, p.Y)
+}
+
+// Dist2 returns the squared Euclidean distance between p and q.
+func (p Point) Dist2(q Point) int {
+	d := p.Sub(q)
+	return d.X*d.X + d.Y*d.Y
+}
+
+// Manhattan returns the taxicab distance between p and q.
+func (p Point) Manhattan(q Point) int {
+	d := p.Sub(q)
+	return abs(d.X) + abs(d.Y)
+}
+
+// Chebyshev returns the chessboard distance between p and q.
+func (p Point) Chebyshev(q Point) int {
+	d := p.Sub(q)
+	return max(abs(d.X), abs(d.Y))
+}
+
+// Point3 is a point in space.
+type Point3 struct {
+	X, Y, Z int
+}
+
+// Add returns p+q.
+func (p Point3) Add(q Point3) Point3 {
+	return Point3{p.X + q.X, p.Y + q.Y, p.Z + q.Z}
+}
+
+// Sub returns p-q.
+func (p Point3) Sub(q Point3) Point3 {
+	return Point3{p.X - q.X, p.Y - q.Y, p.Z - q.Z}
+}
+
+func (p Point3) String() string {
+	return fmt.Sprintf("(%d,%d,%d)", p.X, p.Y, p.Z)
+}
+
+// Dist2 returns the squared Euclidean distance between p and q.
+func (p Point3) Dist2(q Point3) int {
+	d := p.Sub(q)
+	return d.X*d.X + d.Y*d.Y + d.Z*d.Z
+}
+
+// Manhattan returns the taxicab distance between p and q.
+func (p Point3) Manhattan(q Point3) int {
+	d := p.Sub(q)
+	return abs(d.X) + abs(d.Y) + abs(d.Z)
+}
+
+// Chebyshev returns the chessboard distance between p and q.
+func (p Point3) Chebyshev(q Point3) int {
+	d := p.Sub(q)
+	return max(abs(d.X), abs(d.Y), abs(d.Z))
+}
+
+// Rect is the closed axis-aligned rectangle from Min to Max.
+type Rect struct {
+	Min, Max Point
+}
+
+// RectOf returns the smallest Rect containing a and b, which may be any two
+// opposite corners.
+func RectOf(a, b Point) Rect {
+	return Rect{
+		Point{min(a.X, b.X), min(a.Y, b.Y)},
+		Point{max(a.X, b.X), max(a.Y, b.Y)},
+	}
+}
+
+// Width returns the number of columns r covers.
+func (r Rect) Width() int {
+	return r.Max.X - r.Min.X + 1
+}
+
+// Height returns the number of rows r covers.
+func (r Rect) Height() int {
+	return r.Max.Y - r.Min.Y + 1
+}
+
+// Area returns the number of grid cells r covers.
+func (r Rect) Area() int {
+	return r.Width() * r.Height()
+}
+
+// Corners returns the corners of r counterclockwise from Min.
+func (r Rect) Corners() [4]Point {
+	return [4]Point{r.Min, {r.Max.X, r.Min.Y}, r.Max, {r.Min.X, r.Max.Y}}
+}
+
+// Contains reports whether p lies in r or on its boundary.
+func (r Rect) Contains(p Point) bool {
+	return r.Min.X <= p.X && p.X <= r.Max.X && r.Min.Y <= p.Y && p.Y <= r.Max.Y
+}
+
+// Intersects reports whether r and s share at least one point.
+func (r Rect) Intersects(s Rect) bool {
+	return r.Min.X <= s.Max.X && s.Min.X <= r.Max.X && r.Min.Y <= s.Max.Y && s.Min.Y <= r.Max.Y
+}
+
+// Box is the closed axis-aligned box from Min to Max.
+type Box struct {
+	Min, Max Point3
+}
+
+// BoxOf returns the smallest Box containing a and b.
+func BoxOf(a, b Point3) Box {
+	return Box{
+		Point3{min(a.X, b.X), min(a.Y, b.Y), min(a.Z, b.Z)},
+		Point3{max(a.X, b.X), max(a.Y, b.Y), max(a.Z, b.Z)},
+	}
+}
+
+// Volume returns the number of grid cells b covers.
+func (b Box) Volume() int {
+	return (b.Max.X - b.Min.X + 1) * (b.Max.Y - b.Min.Y + 1) * (b.Max.Z - b.Min.Z + 1)
+}
+
+// Contains reports whether p lies in b or on its boundary.
+func (b Box) Contains(p Point3) bool {
+	return b.Min.X <= p.X && p.X <= b.Max.X &&
+		b.Min.Y <= p.Y && p.Y <= b.Max.Y &&
+		b.Min.Z <= p.Z && p.Z <= b.Max.Z
+}
+
+// Intersects reports whether b and c share at least one point.
+func (b Box) Intersects(c Box) bool {
+	return b.Min.X <= c.Max.X && c.Min.X <= b.Max.X &&
+		b.Min.Y <= c.Max.Y && c.Min.Y <= b.Max.Y &&
+		b.Min.Z <= c.Max.Z && c.Min.Z <= b.Max.Z
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
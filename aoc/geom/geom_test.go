@@ -0,0 +1,112 @@
+package geom
+
+import "testing"
+
+func TestDistances(t *testing.T) {
+	p, q := Point{1, 2}, Point{4, -2}
+	if got := p.Dist2(q); got != 25 {
+		t.Errorf("Dist2 = %d, want 25", got)
+	}
+	if got := p.Manhattan(q); got != 7 {
+		t.Errorf("Manhattan = %d, want 7", got)
+	}
+	if got := p.Chebyshev(q); got != 4 {
+		t.Errorf("Chebyshev = %d, want 4", got)
+	}
+
+	a, b := Point3{162, 817, 812}, Point3{425, 690, 689}
+	if got := a.Dist2(b); got != 263*263+127*127+123*123 {
+		t.Errorf("Point3 Dist2 = %d", got)
+	}
+	if got := a.Manhattan(b); got != 263+127+123 {
+		t.Errorf("Point3 Manhattan = %d", got)
+	}
+	if got := a.Chebyshev(b); got != 263 {
+		t.Errorf("Point3 Chebyshev = %d", got)
+	}
+}
+
+func TestRect(t *testing.T) {
+	r := RectOf(Point{11, 7}, Point{2, 3})
+	if r.Min != (Point{2, 3}) || r.Max != (Point{11, 7}) {
+		t.Errorf("RectOf = %v", r)
+	}
+	if got := r.Area(); got != 50 {
+		t.Errorf("Area = %d, want 50", got)
+	}
+	if !r.Contains(Point{2, 7}) || r.Contains(Point{1, 5}) {
+		t.Errorf("Contains is wrong on the boundary")
+	}
+	if !r.Intersects(RectOf(Point{11, 0}, Point{20, 3})) || r.Intersects(RectOf(Point{12, 0}, Point{20, 9})) {
+		t.Errorf("Intersects is wrong at the corners")
+	}
+
+	b := BoxOf(Point3{0, 0, 0}, Point3{1, 2, 3})
+	if got := b.Volume(); got != 24 {
+		t.Errorf("Volume = %d, want 24", got)
+	}
+	if !b.Contains(Point3{1, 2, 3}) || b.Intersects(BoxOf(Point3{2, 0, 0}, Point3{3, 3, 3})) {
+		t.Errorf("Box Contains or Intersects is wrong")
+	}
+}
+
+func TestSegment(t *testing.T) {
+	tests := []struct {
+		s, t Segment
+		want bool
+	}{
+		{Segment{Point{0, 0}, Point{4, 4}}, Segment{Point{0, 4}, Point{4, 0}}, true},
+		{Segment{Point{0, 0}, Point{4, 0}}, Segment{Point{4, 0}, Point{4, 4}}, true},  // touching ends
+		{Segment{Point{0, 0}, Point{4, 0}}, Segment{Point{2, 0}, Point{6, 0}}, true},  // overlapping
+		{Segment{Point{0, 0}, Point{4, 0}}, Segment{Point{5, 0}, Point{6, 0}}, false}, // collinear apart
+		{Segment{Point{0, 0}, Point{4, 0}}, Segment{Point{2, 1}, Point{2, 5}}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.s.Intersects(tt.t); got != tt.want {
+			t.Errorf("%v.Intersects(%v) = %v, want %v", tt.s, tt.t, got, tt.want)
+		}
+		if got := tt.t.Intersects(tt.s); got != tt.want {
+			t.Errorf("%v.Intersects(%v) = %v, want %v", tt.t, tt.s, got, tt.want)
+		}
+	}
+
+	r := RectOf(Point{0, 0}, Point{4, 4})
+	if !(Segment{Point{2, -1}, Point{2, 9}}).CrossesInterior(r) {
+		t.Errorf("segment through the middle does not cross the interior")
+	}
+	if (Segment{Point{0, -1}, Point{0, 9}}).CrossesInterior(r) {
+		t.Errorf("segment along the boundary crosses the interior")
+	}
+}
+
+func TestPolygon(t *testing.T) {
+	// The red tiles of the day 9 example.
+	pg := Polygon{{7, 1}, {11, 1}, {11, 7}, {9, 7}, {9, 5}, {2, 5}, {2, 3}, {7, 3}}
+	tests := []struct {
+		p                 Point
+		boundary, contain bool
+	}{
+		{Point{7, 1}, true, true},
+		{Point{11, 4}, true, true},
+		{Point{8, 2}, false, true},
+		{Point{3, 4}, false, true},
+		{Point{10, 6}, false, true},
+		{Point{3, 2}, false, false},
+		{Point{8, 6}, false, false},
+		{Point{12, 4}, false, false},
+	}
+	for _, tt := range tests {
+		if got := pg.OnBoundary(tt.p); got != tt.boundary {
+			t.Errorf("OnBoundary(%v) = %v, want %v", tt.p, got, tt.boundary)
+		}
+		if got := pg.Contains(tt.p); got != tt.contain {
+			t.Errorf("Contains(%v) = %v, want %v", tt.p, got, tt.contain)
+		}
+		if !tt.boundary && pg.Inside(tt.p) != tt.contain {
+			t.Errorf("Inside(%v) = %v, want %v", tt.p, !tt.contain, tt.contain)
+		}
+	}
+	if got := len(pg.Edges()); got != len(pg) {
+		t.Errorf("Edges returned %d sides, want %d", got, len(pg))
+	}
+}
@@ -0,0 +1,50 @@
+package geom
+
+// Polygon is a simple polygon given by its vertices in order. The last
+// vertex connects back to the first.
+type Polygon []Point
+
+// Edges returns the sides of pg in order.
+func (pg Polygon) Edges() []Segment {
+	edges := make([]Segment, len(pg))
+	for i := range pg {
+		edges[i] = Segment{pg[i], pg[(i+1)%len(pg)]}
+	}
+	return edges
+}
+
+// OnBoundary reports whether p lies on one of the sides of pg.
+func (pg Polygon) OnBoundary(p Point) bool {
+	for i := range pg {
+		if (Segment{pg[i], pg[(i+1)%len(pg)]}).Contains(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// Inside reports whether p lies strictly inside pg by casting a ray from p
+// towards +X and counting the sides it crosses. The answer for points on
+// the boundary depends on the side; use Contains to include them.
+func (pg Polygon) Inside(p Point) bool {
+	inside := false
+	for i := range pg {
+		a, b := pg[i], pg[(i+1)%len(pg)]
+		if (a.Y <= p.Y) == (b.Y <= p.Y) {
+			continue
+		}
+		// The side crosses the horizontal line through p; p is left of
+		// the crossing if it lies on the same side of a→b as a point far
+		// to the left would, which for an upward side is the left.
+		c := cross(a, b, p)
+		if (b.Y > a.Y && c > 0) || (b.Y < a.Y && c < 0) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// Contains reports whether p lies inside pg or on its boundary.
+func (pg Polygon) Contains(p Point) bool {
+	return pg.OnBoundary(p) || pg.Inside(p)
+}
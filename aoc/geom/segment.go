@@ -0,0 +1,58 @@
+package geom
+
+// Segment is the closed line segment from A to B.
+type Segment struct {
+	A, B Point
+}
+
+// Bounds returns the smallest Rect containing s.
+func (s Segment) Bounds() Rect {
+	return RectOf(s.A, s.B)
+}
+
+// Contains reports whether p lies on s, end points included.
+func (s Segment) Contains(p Point) bool {
+	return cross(s.A, s.B, p) == 0 && s.Bounds().Contains(p)
+}
+
+// Intersects reports whether s and t share at least one point, including
+// when they only touch or overlap along a line.
+func (s Segment) Intersects(t Segment) bool {
+	d1 := sign(cross(t.A, t.B, s.A))
+	d2 := sign(cross(t.A, t.B, s.B))
+	d3 := sign(cross(s.A, s.B, t.A))
+	d4 := sign(cross(s.A, s.B, t.B))
+
+	if d1*d2 < 0 && d3*d4 < 0 {
+		return true
+	}
+	return (d1 == 0 && t.Contains(s.A)) ||
+		(d2 == 0 && t.Contains(s.B)) ||
+		(d3 == 0 && s.Contains(t.A)) ||
+		(d4 == 0 && s.Contains(t.B))
+}
+
+// CrossesInterior reports whether an axis-aligned s passes through the
+// open interior of r, as opposed to missing r or only running along or
+// touching its boundary.
+func (s Segment) CrossesInterior(r Rect) bool {
+	b := s.Bounds()
+	return r.Min.X < b.Max.X && b.Min.X < r.Max.X && r.Min.Y < b.Max.Y && b.Min.Y < r.Max.Y
+}
+
+// cross returns the z component of (b-a)×(c-a): positive if c lies to the
+// left of the line from a to b, negative to the right and 0 on it.
+func cross(a, b, c Point) int {
+	u, v := b.Sub(a), c.Sub(a)
+	return u.X*v.Y - u.Y*v.X
+}
+
+func sign(n int) int {
+	switch {
+	case n > 0:
+		return 1
+	case n < 0:
+		return -1
+	}
+	return 0
+}
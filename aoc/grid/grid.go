@@ -0,0 +1,308 @@
+// Package grid provides a rectangular two-dimensional grid of cells, as
+// found in many puzzle inputs.
+//
+// Cells are addressed by a Point of row and column, with row 0 at the top
+// and column 0 on the left. Access through At, Get and Set is bounds checked
+// against the grid, and the neighbour iterators only yield points inside it.
+package grid
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+)
+
+// Point is the position of a cell.
+type Point struct {
+	Row, Col int
+}
+
+// Add returns p moved by d.
+func (p Point) Add(d Point) Point {
+	return Point{p.Row + d.Row, p.Col + d.Col}
+}
+
+func (p Point) String() string {
+	return fmt.Sprintf("(%d,%d)", p.Row, p.Col)
+}
+
+// Directions to the orthogonal neighbours, clockwise from up.
+var (
+	Up    = Point{-1, 0}
+	Right = Point{0, 1}
+	Down  = Point{1, 0}
+	Left  = Point{0, -1}
+)
+
+// Dirs4 are the directions to the four orthogonal neighbours of a cell,
+// clockwise from up.
+var Dirs4 = []Point{Up, Right, Down, Left}
+
+// Dirs8 are the directions to all eight neighbours of a cell, in reading
+// order.
+var Dirs8 = []Point{
+	{-1, -1}, {-1, 0}, {-1, 1},
+	{0, -1}, {0, 1},
+	{1, -1}, {1, 0}, {1, 1},
+}
+
+// Grid is a rectangular grid of cells of type T. The zero value is an empty
+// grid.
+type Grid[T any] struct {
+	Rows, Cols int
+	cells      []T
+}
+
+// New returns a grid of rows by cols zero cells.
+func New[T any](rows, cols int) *Grid[T] {
+	if rows < 0 || cols < 0 {
+		panic(fmt.Sprintf("grid: New(%d, %d) with negative size", rows, cols))
+	}
+	return &Grid[T]{Rows: rows, Cols: cols, cells: make([]T, rows*cols)}
+}
+
+// FromRows returns a grid holding a copy of rows, which must all have the
+// same length.
+func FromRows[T any](rows [][]T) (*Grid[T], error) {
+	if len(rows) == 0 {
+		return New[T](0, 0), nil
+	}
+	g := New[T](len(rows), len(rows[0]))
+	for i, row := range rows {
+		if len(row) != g.Cols {
+			return nil, fmt.Errorf("grid: row %d has %d cells, want %d", i+1, len(row), g.Cols)
+		}
+		copy(g.Row(i), row)
+	}
+	return g, nil
+}
+
+// FromLines returns a grid of the bytes of lines, which must all have the
+// same length.
+func FromLines(lines []string) (*Grid[byte], error) {
+	rows := make([][]byte, len(lines))
+	for i, line := range lines {
+		rows[i] = []byte(line)
+	}
+	return FromRows(rows)
+}
+
+// Parse reads a grid of bytes from r, one row per non-empty line.
+func Parse(r io.Reader) (*Grid[byte], error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if line := strings.TrimRight(scanner.Text(), "\r"); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return FromLines(lines)
+}
+
+// Map returns a grid of the same size as g holding f of each cell.
+func Map[T, U any](g *Grid[T], f func(T) U) *Grid[U] {
+	m := New[U](g.Rows, g.Cols)
+	for i, v := range g.cells {
+		m.cells[i] = f(v)
+	}
+	return m
+}
+
+// In reports whether p lies inside the grid.
+func (g *Grid[T]) In(p Point) bool {
+	return p.Row >= 0 && p.Row < g.Rows && p.Col >= 0 && p.Col < g.Cols
+}
+
+// At returns the cell at p. It panics if p is outside the grid.
+func (g *Grid[T]) At(p Point) T {
+	return g.cells[g.index(p)]
+}
+
+// Get returns the cell at p and whether p is inside the grid, so callers
+// can treat the outside as empty.
+func (g *Grid[T]) Get(p Point) (T, bool) {
+	if !g.In(p) {
+		var zero T
+		return zero, false
+	}
+	return g.cells[p.Row*g.Cols+p.Col], true
+}
+
+// Set sets the cell at p. It panics if p is outside the grid.
+func (g *Grid[T]) Set(p Point, v T) {
+	g.cells[g.index(p)] = v
+}
+
+func (g *Grid[T]) index(p Point) int {
+	if !g.In(p) {
+		panic(fmt.Sprintf("grid: point %v outside %dx%d grid", p, g.Rows, g.Cols))
+	}
+	return p.Row*g.Cols + p.Col
+}
+
+// Row returns row i. The slice shares the grid's storage.
+func (g *Grid[T]) Row(i int) []T {
+	return g.cells[i*g.Cols : (i+1)*g.Cols : (i+1)*g.Cols]
+}
+
+// All yields every point of the grid and its cell in reading order.
+func (g *Grid[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for i, v := range g.cells {
+			if !yield(Point{i / g.Cols, i % g.Cols}, v) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors4 yields the orthogonal neighbours of p that lie inside the grid.
+func (g *Grid[T]) Neighbors4(p Point) iter.Seq[Point] {
+	return g.neighbors(p, Dirs4)
+}
+
+// Neighbors8 yields all neighbours of p, diagonals included, that lie
+// inside the grid.
+func (g *Grid[T]) Neighbors8(p Point) iter.Seq[Point] {
+	return g.neighbors(p, Dirs8)
+}
+
+func (g *Grid[T]) neighbors(p Point, dirs []Point) iter.Seq[Point] {
+	return func(yield func(Point) bool) {
+		for _, d := range dirs {
+			if n := p.Add(d); g.In(n) && !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+// FindFunc returns the first point in reading order whose cell satisfies f.
+func (g *Grid[T]) FindFunc(f func(T) bool) (Point, bool) {
+	for p, v := range g.All() {
+		if f(v) {
+			return p, true
+		}
+	}
+	return Point{}, false
+}
+
+// Find returns the first point in reading order whose cell is v.
+func Find[T comparable](g *Grid[T], v T) (Point, bool) {
+	return g.FindFunc(func(c T) bool { return c == v })
+}
+
+// CountFunc returns the number of cells that satisfy f.
+func (g *Grid[T]) CountFunc(f func(T) bool) int {
+	n := 0
+	for _, v := range g.cells {
+		if f(v) {
+			n++
+		}
+	}
+	return n
+}
+
+// Equal reports whether a and b have the same size and cells.
+func Equal[T comparable](a, b *Grid[T]) bool {
+	if a.Rows != b.Rows || a.Cols != b.Cols {
+		return false
+	}
+	for i := range a.cells {
+		if a.cells[i] != b.cells[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a copy of g.
+func (g *Grid[T]) Clone() *Grid[T] {
+	c := New[T](g.Rows, g.Cols)
+	copy(c.cells, g.cells)
+	return c
+}
+
+// Transpose returns g mirrored along its main diagonal.
+func (g *Grid[T]) Transpose() *Grid[T] {
+	return g.remap(g.Cols, g.Rows, func(p Point) Point { return Point{p.Col, p.Row} })
+}
+
+// RotateCW returns g rotated a quarter turn clockwise.
+func (g *Grid[T]) RotateCW() *Grid[T] {
+	return g.remap(g.Cols, g.Rows, func(p Point) Point { return Point{p.Col, g.Rows - 1 - p.Row} })
+}
+
+// RotateCCW returns g rotated a quarter turn counterclockwise.
+func (g *Grid[T]) RotateCCW() *Grid[T] {
+	return g.remap(g.Cols, g.Rows, func(p Point) Point { return Point{g.Cols - 1 - p.Col, p.Row} })
+}
+
+// FlipH returns g mirrored left to right.
+func (g *Grid[T]) FlipH() *Grid[T] {
+	return g.remap(g.Rows, g.Cols, func(p Point) Point { return Point{p.Row, g.Cols - 1 - p.Col} })
+}
+
+// FlipV returns g mirrored top to bottom.
+func (g *Grid[T]) FlipV() *Grid[T] {
+	return g.remap(g.Rows, g.Cols, func(p Point) Point { return Point{g.Rows - 1 - p.Row, p.Col} })
+}
+
+// remap returns a rows by cols grid where the cell of g at p moves to to(p).
+func (g *Grid[T]) remap(rows, cols int, to func(Point) Point) *Grid[T] {
+	m := New[T](rows, cols)
+	for p, v := range g.All() {
+		m.Set(to(p), v)
+	}
+	return m
+}
+
+// Format returns the grid as text, one line per row, with each cell
+// written by cell.
+func (g *Grid[T]) Format(cell func(T) string) string {
+	var b strings.Builder
+	for i := range g.Rows {
+		for _, v := range g.Row(i) {
+			b.WriteString(cell(v))
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// String returns the grid as text, one line per row. Byte and rune cells
+// are written as characters, bool cells as '#' and '.', and other cells
+// with fmt separated by spaces.
+func (g *Grid[T]) String() string {
+	var zero T
+	switch any(zero).(type) {
+	case byte:
+		return g.Format(func(v T) string { return string([]byte{any(v).(byte)}) })
+	case rune:
+		return g.Format(func(v T) string { return string(any(v).(rune)) })
+	case bool:
+		return g.Format(func(v T) string {
+			if any(v).(bool) {
+				return "#"
+			}
+			return "."
+		})
+	}
+
+	var b strings.Builder
+	for i := range g.Rows {
+		for j, v := range g.Row(i) {
+			if j > 0 {
+				b.WriteByte(' ')
+			}
+			fmt.Fprint(&b, v)
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
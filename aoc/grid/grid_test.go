@@ -0,0 +1,133 @@
+package grid
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func mustParse(t *testing.T, s string) *Grid[byte] {
+	t.Helper()
+	g, err := Parse(strings.NewReader(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestParse(t *testing.T) {
+	g := mustParse(t, "ab\ncd\nef\n")
+	if g.Rows != 3 || g.Cols != 2 {
+		t.Fatalf("size = %dx%d, want 3x2", g.Rows, g.Cols)
+	}
+	if got := g.At(Point{2, 1}); got != 'f' {
+		t.Errorf("At(2,1) = %q, want 'f'", got)
+	}
+	if got := g.String(); got != "ab\ncd\nef\n" {
+		t.Errorf("String() = %q", got)
+	}
+
+	if _, err := Parse(strings.NewReader("ab\nc\n")); err == nil {
+		t.Errorf("Parse of ragged rows succeeded")
+	}
+}
+
+func TestBounds(t *testing.T) {
+	g := mustParse(t, "ab\ncd\n")
+	for _, p := range []Point{{-1, 0}, {0, -1}, {2, 0}, {0, 2}} {
+		if g.In(p) {
+			t.Errorf("In(%v) = true", p)
+		}
+		if _, ok := g.Get(p); ok {
+			t.Errorf("Get(%v) ok", p)
+		}
+	}
+	if v, ok := g.Get(Point{1, 0}); !ok || v != 'c' {
+		t.Errorf("Get(1,0) = %q, %v", v, ok)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("At outside the grid did not panic")
+		}
+	}()
+	g.At(Point{0, 2})
+}
+
+func TestNeighbors(t *testing.T) {
+	g := New[int](3, 3)
+	tests := []struct {
+		p      Point
+		n4, n8 int
+	}{
+		{Point{0, 0}, 2, 3},
+		{Point{0, 1}, 3, 5},
+		{Point{1, 1}, 4, 8},
+	}
+	for _, tt := range tests {
+		if got := len(slices.Collect(g.Neighbors4(tt.p))); got != tt.n4 {
+			t.Errorf("Neighbors4(%v) yields %d points, want %d", tt.p, got, tt.n4)
+		}
+		if got := len(slices.Collect(g.Neighbors8(tt.p))); got != tt.n8 {
+			t.Errorf("Neighbors8(%v) yields %d points, want %d", tt.p, got, tt.n8)
+		}
+	}
+}
+
+func TestFind(t *testing.T) {
+	g := mustParse(t, "..\n.S\n")
+	if p, ok := Find(g, 'S'); !ok || p != (Point{1, 1}) {
+		t.Errorf("Find(S) = %v, %v", p, ok)
+	}
+	if _, ok := Find(g, 'X'); ok {
+		t.Errorf("Find(X) found a cell")
+	}
+	if got := g.CountFunc(func(c byte) bool { return c == '.' }); got != 3 {
+		t.Errorf("CountFunc(.) = %d, want 3", got)
+	}
+}
+
+func TestTransforms(t *testing.T) {
+	g := mustParse(t, "abc\ndef\n")
+	tests := []struct {
+		name string
+		got  *Grid[byte]
+		want string
+	}{
+		{"Transpose", g.Transpose(), "ad\nbe\ncf\n"},
+		{"RotateCW", g.RotateCW(), "da\neb\nfc\n"},
+		{"RotateCCW", g.RotateCCW(), "cf\nbe\nad\n"},
+		{"FlipH", g.FlipH(), "cba\nfed\n"},
+		{"FlipV", g.FlipV(), "def\nabc\n"},
+	}
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%s =\n%s want\n%s", tt.name, got, tt.want)
+		}
+	}
+
+	if !Equal(g.RotateCW().RotateCW().RotateCW().RotateCW(), g) {
+		t.Errorf("four clockwise rotations changed the grid")
+	}
+	c := g.Clone()
+	c.Set(Point{0, 0}, 'x')
+	if g.At(Point{0, 0}) != 'a' {
+		t.Errorf("Clone shares cells with the original")
+	}
+}
+
+func TestString(t *testing.T) {
+	b := Map(mustParse(t, "#.\n.#\n"), func(c byte) bool { return c == '#' })
+	if got := b.String(); got != "#.\n.#\n" {
+		t.Errorf("bool String() = %q", got)
+	}
+	n := Map(b, func(v bool) int {
+		if v {
+			return 1
+		}
+		return 0
+	})
+	if got := n.String(); got != "1 0\n0 1\n" {
+		t.Errorf("int String() = %q", got)
+	}
+}
@@ -0,0 +1,252 @@
+// Package history keeps a log of every part the runner solves, to tell
+// when an answer changed or a solver got slower.
+package history
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/janneh/advent-of-code-2025/aoc/cache"
+)
+
+// File is the name of the run history in the cache directory.
+const File = "history.jsonl"
+
+// DefaultFile returns the run history in the cache directory of the
+// repository, so each checkout keeps its own.
+func DefaultFile() (string, error) {
+	dir, err := cache.RepoDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, File), nil
+}
+
+// Flags choose the run history from the command line.
+type Flags struct {
+	file string
+	off  bool
+}
+
+// RegisterFlags adds the -history and -no-history flags to fs.
+func (f *Flags) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&f.file, "history", "", "run history to append to (default "+cache.RepoDirName+"/"+File+" in the repository)")
+	fs.BoolVar(&f.off, "no-history", false, "do not record this run in the history")
+}
+
+// File returns the run history chosen by the flags, or an empty string
+// with -no-history.
+func (f *Flags) File() (string, error) {
+	if f.off {
+		return "", nil
+	}
+	if f.file != "" {
+		return f.file, nil
+	}
+	return DefaultFile()
+}
+
+// Run is one solved part as recorded in the history.
+type Run struct {
+	Day  int `json:"day"`
+	Part int `json:"part"`
+	// Input is the name of the input file and InputHash identifies its
+	// contents, see Hash.
+	Input     string        `json:"input"`
+	InputHash string        `json:"input_hash"`
+	Answer    string        `json:"answer,omitempty"`
+	Err       string        `json:"error,omitempty"`
+	Duration  time.Duration `json:"duration_ns"`
+	// Revision is the git revision of the solvers, see Revision.
+	Revision string    `json:"revision,omitempty"`
+	Time     time.Time `json:"time"`
+}
+
+// Hash returns the hash identifying an input with the given contents.
+func Hash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+// Read reads the history in filename, one JSON object per line. A missing
+// history has no runs.
+func Read(filename string) ([]Run, error) {
+	file, err := os.Open(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var runs []Run
+	scanner := bufio.NewScanner(file)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var r Run
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", filename, lineNum, err)
+		}
+		runs = append(runs, r)
+	}
+	return runs, scanner.Err()
+}
+
+// appendMu keeps the runs of parts solved at once from interleaving.
+var appendMu sync.Mutex
+
+// Append adds runs to the history in filename.
+func Append(filename string, runs ...Run) error {
+	var data []byte
+	for _, r := range runs {
+		line, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		data = append(append(data, line...), '\n')
+	}
+
+	appendMu.Lock()
+	defer appendMu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+var revision = sync.OnceValue(func() string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		var rev string
+		dirty := false
+		for _, s := range info.Settings {
+			switch s.Key {
+			case "vcs.revision":
+				rev = s.Value
+			case "vcs.modified":
+				dirty = s.Value == "true"
+			}
+		}
+		if rev != "" {
+			return shortRevision(rev, dirty)
+		}
+	}
+
+	// go run does not stamp the revision, so ask git.
+	out, err := exec.Command("git", "rev-parse", "HEAD").Output()
+	if err != nil {
+		return ""
+	}
+	status, err := exec.Command("git", "status", "--porcelain").Output()
+	return shortRevision(strings.TrimSpace(string(out)), err == nil && len(status) > 0)
+})
+
+// Revision returns the short git revision the running binary was built
+// from, with a "-dirty" suffix when the tree had uncommitted changes, or
+// an empty string outside a git checkout.
+func Revision() string {
+	return revision()
+}
+
+func shortRevision(rev string, dirty bool) string {
+	if len(rev) > 12 {
+		rev = rev[:12]
+	}
+	if dirty {
+		rev += "-dirty"
+	}
+	return rev
+}
+
+// Trend is a run next to the previous successful run of the same part on
+// the same input, if there is one.
+type Trend struct {
+	Run      Run
+	Previous *Run
+}
+
+// Trends pairs every run with the one before it on the same day, part and
+// input hash. Runs that failed are kept but never become the previous run
+// of another.
+func Trends(runs []Run) []Trend {
+	type key struct {
+		day, part int
+		hash      string
+	}
+	last := make(map[key]*Run)
+	trends := make([]Trend, len(runs))
+	for i := range runs {
+		k := key{runs[i].Day, runs[i].Part, runs[i].InputHash}
+		trends[i] = Trend{Run: runs[i], Previous: last[k]}
+		if runs[i].Err == "" {
+			last[k] = &runs[i]
+		}
+	}
+	return trends
+}
+
+// AnswerChanged reports whether the run succeeded with another answer than
+// the previous one.
+func (t Trend) AnswerChanged() bool {
+	return t.Previous != nil && t.Run.Err == "" && t.Run.Answer != t.Previous.Answer
+}
+
+// Slowdown returns how many times longer the run took than the previous
+// one, or 0 without a previous run.
+func (t Trend) Slowdown() float64 {
+	if t.Previous == nil || t.Previous.Duration <= 0 {
+		return 0
+	}
+	return float64(t.Run.Duration) / float64(t.Previous.Duration)
+}
+
+// WriteTable writes trends as an aligned table, one run per line, with the
+// change in duration since the previous run and any change of answer.
+func WriteTable(w io.Writer, trends []Trend) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "TIME\tDAY\tPART\tINPUT\tHASH\tANSWER\tDURATION\tΔ DURATION\tREVISION\t")
+	for _, t := range trends {
+		r := t.Run
+		answer := r.Answer
+		if r.Err != "" {
+			answer = "error: " + r.Err
+		}
+		change := "new"
+		if t.Previous != nil {
+			change = fmt.Sprintf("%+.1f%%", (t.Slowdown()-1)*100)
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t%s\t%s\t%v\t%s\t%s\t", r.Time.Local().Format(time.DateTime), r.Day, r.Part,
+			r.Input, r.InputHash, answer, r.Duration.Round(time.Microsecond), change, r.Revision)
+		if t.AnswerChanged() {
+			fmt.Fprintf(tw, "answer changed, was %s", t.Previous.Answer)
+		}
+		fmt.Fprintln(tw)
+	}
+	return tw.Flush()
+}
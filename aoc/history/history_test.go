@@ -0,0 +1,90 @@
+package history_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/janneh/advent-of-code-2025/aoc/history"
+)
+
+func TestAppendRead(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "cache", history.File)
+	if runs, err := history.Read(filename); err != nil || runs != nil {
+		t.Fatalf("Read of a missing history = %v, %v, want nothing", runs, err)
+	}
+
+	want := []history.Run{
+		{Day: 5, Part: 1, Input: "example.txt", InputHash: history.Hash([]byte("3-5\n\n5\n")), Answer: "1", Duration: time.Millisecond, Revision: "abc", Time: time.Unix(1, 0).UTC()},
+		{Day: 5, Part: 2, Input: "example.txt", InputHash: history.Hash([]byte("3-5\n\n5\n")), Err: "timed out after 1s", Time: time.Unix(2, 0).UTC()},
+	}
+	if err := history.Append(filename, want[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := history.Append(filename, want[1]); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := history.Read(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("read %d runs, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("run %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestTrends(t *testing.T) {
+	runs := []history.Run{
+		{Day: 1, Part: 1, InputHash: "a", Answer: "3", Duration: 10},
+		{Day: 1, Part: 1, InputHash: "b", Answer: "4", Duration: 10},
+		{Day: 1, Part: 1, InputHash: "a", Err: "boom", Duration: 50},
+		{Day: 1, Part: 2, InputHash: "a", Answer: "3", Duration: 10},
+		{Day: 1, Part: 1, InputHash: "a", Answer: "5", Duration: 20},
+		{Day: 1, Part: 1, InputHash: "a", Answer: "5", Duration: 10},
+	}
+	tests := []struct {
+		previous int // index of the previous run, -1 for none
+		changed  bool
+		slowdown float64
+	}{
+		{-1, false, 0},
+		{-1, false, 0},
+		{0, false, 5},
+		{-1, false, 0},
+		{0, true, 2},
+		{4, false, 0.5},
+	}
+
+	trends := history.Trends(runs)
+	for i, tt := range tests {
+		trend := trends[i]
+		switch {
+		case tt.previous == -1 && trend.Previous != nil:
+			t.Errorf("run %d has previous run %+v, want none", i, *trend.Previous)
+		case tt.previous != -1 && (trend.Previous == nil || *trend.Previous != runs[tt.previous]):
+			t.Errorf("run %d has previous run %v, want run %d", i, trend.Previous, tt.previous)
+		}
+		if got := trend.AnswerChanged(); got != tt.changed {
+			t.Errorf("run %d AnswerChanged() = %v, want %v", i, got, tt.changed)
+		}
+		if got := trend.Slowdown(); got != tt.slowdown {
+			t.Errorf("run %d Slowdown() = %v, want %v", i, got, tt.slowdown)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := history.WriteTable(&buf, trends); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "answer changed, was 3") || strings.Count(buf.String(), "answer changed") != 1 {
+		t.Errorf("table does not flag the one changed answer:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,47 @@
+package aoc
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Stdin is the input name that reads the puzzle input from standard input.
+const Stdin = "-"
+
+// DefaultInput returns the puzzle input path of day relative to the
+// repository root.
+func DefaultInput(day int) string {
+	return fmt.Sprintf("day%02d/input.txt", day)
+}
+
+// OpenInput opens the named puzzle input, or standard input for Stdin.
+func OpenInput(name string) (io.ReadCloser, error) {
+	if name == Stdin {
+		return io.NopCloser(os.Stdin), nil
+	}
+	return os.Open(name)
+}
+
+// InputFiles returns the regular files directly inside dir in name order,
+// skipping hidden files.
+func InputFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		files = append(files, filepath.Join(dir, entry.Name()))
+	}
+	sort.Strings(files)
+
+	return files, nil
+}
@@ -0,0 +1,94 @@
+package aoc
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+)
+
+// Profile names the files profiling data is written to. Empty names turn
+// the corresponding profile off.
+type Profile struct {
+	// CPU is a pprof CPU profile covering everything between Start and stop.
+	CPU string
+	// Mem is a pprof heap profile written when profiling stops.
+	Mem string
+	// Trace is a runtime execution trace, see go tool trace.
+	Trace string
+}
+
+// RegisterFlags adds the -cpuprofile, -memprofile and -trace flags to fs.
+func (p *Profile) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&p.CPU, "cpuprofile", "", "write a CPU profile to this file")
+	fs.StringVar(&p.Mem, "memprofile", "", "write a heap profile to this file when done")
+	fs.StringVar(&p.Trace, "trace", "", "write an execution trace to this file")
+}
+
+// Start starts the requested profiles and returns a function that stops
+// them and writes the remaining files. Solving runs each part under pprof
+// labels and a trace region naming its day and part, so a profile of
+// several days can be split up with pprof's -tagfocus.
+func (p Profile) Start() (stop func() error, err error) {
+	var stops []func() error
+	stopAll := func() error {
+		var errs []error
+		for i := len(stops) - 1; i >= 0; i-- {
+			errs = append(errs, stops[i]())
+		}
+		return errors.Join(errs...)
+	}
+
+	if p.CPU != "" {
+		file, err := os.Create(p.CPU)
+		if err != nil {
+			return nil, err
+		}
+		if err := pprof.StartCPUProfile(file); err != nil {
+			file.Close()
+			return nil, fmt.Errorf("starting CPU profile: %w", err)
+		}
+		stops = append(stops, func() error {
+			pprof.StopCPUProfile()
+			return file.Close()
+		})
+	}
+
+	if p.Trace != "" {
+		file, err := os.Create(p.Trace)
+		if err != nil {
+			stopAll()
+			return nil, err
+		}
+		if err := trace.Start(file); err != nil {
+			file.Close()
+			stopAll()
+			return nil, fmt.Errorf("starting trace: %w", err)
+		}
+		stops = append(stops, func() error {
+			trace.Stop()
+			return file.Close()
+		})
+	}
+
+	if p.Mem != "" {
+		file, err := os.Create(p.Mem)
+		if err != nil {
+			stopAll()
+			return nil, err
+		}
+		stops = append(stops, func() error {
+			runtime.GC()
+			if err := pprof.WriteHeapProfile(file); err != nil {
+				file.Close()
+				return fmt.Errorf("writing heap profile: %w", err)
+			}
+			return file.Close()
+		})
+	}
+
+	return stopAll, nil
+}
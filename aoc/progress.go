@@ -0,0 +1,174 @@
+package aoc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Progress receives reports from solvers that run long enough to be worth
+// watching. Implementations must be safe for concurrent use, as RunAll
+// solves several parts at once.
+type Progress interface {
+	Report(e Event)
+}
+
+// Event is one progress report of a solver. Solvers fill in the phase and
+// counts; Report adds the day and part being solved.
+type Event struct {
+	Day   int    `json:"day"`
+	Part  int    `json:"part"`
+	Phase string `json:"phase"`
+	// Done counts the steps of the phase finished so far, out of Total, or
+	// out of an unknown number when Total is 0.
+	Done  int `json:"done"`
+	Total int `json:"total,omitempty"`
+	// Best is the best answer found so far, for searches that improve on
+	// one as they go.
+	Best any `json:"best,omitempty"`
+}
+
+// PhaseDone is the phase of the event reported once a part returns.
+const PhaseDone = "done"
+
+// Progress renderers accepted by NewProgress.
+const (
+	ProgressAuto = "auto"
+	ProgressBar  = "bar"
+	ProgressJSON = "json"
+	ProgressNone = "none"
+)
+
+// NewProgress returns a renderer writing to w: bar draws a progress bar on
+// a single line, json writes one event object per line and none discards
+// every event. auto picks bar when w is a terminal and none otherwise.
+func NewProgress(w io.Writer, renderer string) (Progress, error) {
+	switch renderer {
+	case ProgressAuto, "":
+		if isTerminal(w) {
+			return &barProgress{w: w}, nil
+		}
+		return SilentProgress, nil
+	case ProgressBar:
+		return &barProgress{w: w}, nil
+	case ProgressJSON:
+		return &jsonProgress{enc: json.NewEncoder(w)}, nil
+	case ProgressNone:
+		return SilentProgress, nil
+	}
+	return nil, fmt.Errorf("unknown progress renderer %q", renderer)
+}
+
+// SilentProgress discards every event.
+var SilentProgress Progress = silentProgress{}
+
+type silentProgress struct{}
+
+func (silentProgress) Report(Event) {}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+type progressKey struct{}
+
+// WithProgress returns a copy of ctx that makes Report send the events of
+// solvers run with it to p.
+func WithProgress(ctx context.Context, p Progress) context.Context {
+	return context.WithValue(ctx, progressKey{}, p)
+}
+
+type partKey struct{}
+
+// withPart labels the progress events and trace steps of the solvers run
+// with ctx with day and part.
+func withPart(ctx context.Context, day, part int) context.Context {
+	return context.WithValue(ctx, partKey{}, [2]int{day, part})
+}
+
+// partOf returns the day and part ctx was labelled with.
+func partOf(ctx context.Context) (day, part int) {
+	label, _ := ctx.Value(partKey{}).([2]int)
+	return label[0], label[1]
+}
+
+// Report sends e to the Progress carried by ctx, if any. Solvers should
+// report every few thousand steps rather than every step; renderers decide
+// how often to draw.
+func Report(ctx context.Context, e Event) {
+	p, ok := ctx.Value(progressKey{}).(Progress)
+	if !ok || p == nil {
+		return
+	}
+	e.Day, e.Part = partOf(ctx)
+	p.Report(e)
+}
+
+// barRedraw is how often the bar redraws for events of the same phase.
+const barRedraw = 100 * time.Millisecond
+
+// barWidth is the number of cells of the bar.
+const barWidth = 30
+
+type barProgress struct {
+	mu     sync.Mutex
+	w      io.Writer
+	last   Event
+	drawn  time.Time
+	showed bool
+}
+
+func (b *barProgress) Report(e Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if e.Phase == PhaseDone {
+		if b.showed {
+			fmt.Fprint(b.w, "\r\033[K")
+			b.showed = false
+		}
+		return
+	}
+	same := e.Day == b.last.Day && e.Part == b.last.Part && e.Phase == b.last.Phase
+	if same && time.Since(b.drawn) < barRedraw {
+		return
+	}
+	b.last, b.drawn, b.showed = e, time.Now(), true
+
+	var line strings.Builder
+	fmt.Fprintf(&line, "\r\033[KDay %d Part %d: %s", e.Day, e.Part, e.Phase)
+	if e.Total > 0 {
+		filled := min(barWidth*e.Done/e.Total, barWidth)
+		fmt.Fprintf(&line, " [%s%s] %d/%d", strings.Repeat("#", filled), strings.Repeat("-", barWidth-filled), e.Done, e.Total)
+	} else if e.Done > 0 {
+		fmt.Fprintf(&line, " %d", e.Done)
+	}
+	if e.Best != nil {
+		fmt.Fprintf(&line, ", best %v", e.Best)
+	}
+	io.WriteString(b.w, line.String())
+}
+
+type jsonProgress struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+func (j *jsonProgress) Report(e Event) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.enc.Encode(struct {
+		Time time.Time `json:"time"`
+		Event
+	}{time.Now(), e})
+}
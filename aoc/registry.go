@@ -0,0 +1,37 @@
+package aoc
+
+import (
+	"fmt"
+	"sort"
+)
+
+var solvers = make(map[int]Solver)
+
+// Register makes a solver available for the given day. It is meant to be
+// called from the init function of each day package and panics if the day
+// is registered twice.
+func Register(day int, s Solver) {
+	if s == nil {
+		panic(fmt.Sprintf("aoc: Register solver for day %d is nil", day))
+	}
+	if _, dup := solvers[day]; dup {
+		panic(fmt.Sprintf("aoc: Register called twice for day %d", day))
+	}
+	solvers[day] = s
+}
+
+// Lookup returns the solver registered for day.
+func Lookup(day int) (Solver, bool) {
+	s, ok := solvers[day]
+	return s, ok
+}
+
+// Days returns the registered days in ascending order.
+func Days() []int {
+	days := make([]int, 0, len(solvers))
+	for day := range solvers {
+		days = append(days, day)
+	}
+	sort.Ints(days)
+	return days
+}
@@ -0,0 +1,108 @@
+package remote
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// LogFile is the name of the answer log in the cache directory.
+const LogFile = "answers.jsonl"
+
+// Attempt is one submitted answer as recorded in the answer log.
+type Attempt struct {
+	Day     int       `json:"day"`
+	Part    int       `json:"part"`
+	Answer  string    `json:"answer"`
+	Verdict Verdict   `json:"verdict"`
+	Message string    `json:"message,omitempty"`
+	Time    time.Time `json:"time"`
+}
+
+// ReadLog reads the answer log in filename, one JSON object per line. A
+// missing log has no attempts.
+func ReadLog(filename string) ([]Attempt, error) {
+	file, err := os.Open(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var attempts []Attempt
+	scanner := bufio.NewScanner(file)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var a Attempt
+		if err := json.Unmarshal(scanner.Bytes(), &a); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", filename, lineNum, err)
+		}
+		attempts = append(attempts, a)
+	}
+	return attempts, scanner.Err()
+}
+
+// AppendLog adds a to the answer log in filename.
+func AppendLog(filename string, a Attempt) error {
+	if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// ErrRejected is wrapped by the errors of CheckAnswer.
+var ErrRejected = errors.New("answer rejected")
+
+// CheckAnswer returns an error wrapping ErrRejected if the earlier attempts
+// already show that answer is not the one for part of day: the part was
+// solved, the same answer was wrong, or a numeric answer is no lower than
+// one that was too high or no higher than one that was too low.
+func CheckAnswer(attempts []Attempt, day, part int, answer string) error {
+	n, numeric := new(big.Int).SetString(answer, 10)
+	for _, a := range attempts {
+		if a.Day != day || a.Part != part {
+			continue
+		}
+		if a.Verdict == Correct {
+			return fmt.Errorf("%w: day %d part %d was already solved with %s", ErrRejected, day, part, a.Answer)
+		}
+		if a.Answer == answer && a.Verdict.Known() {
+			return fmt.Errorf("%w: %s was already submitted and was %s", ErrRejected, answer, a.Verdict)
+		}
+
+		prev, ok := new(big.Int).SetString(a.Answer, 10)
+		if !numeric || !ok {
+			continue
+		}
+		if a.Verdict == TooHigh && n.Cmp(prev) >= 0 {
+			return fmt.Errorf("%w: %s is not below %s, which was too high", ErrRejected, answer, a.Answer)
+		}
+		if a.Verdict == TooLow && n.Cmp(prev) <= 0 {
+			return fmt.Errorf("%w: %s is not above %s, which was too low", ErrRejected, answer, a.Answer)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+// Package remote talks to the Advent of Code website, or anything that
+// serves the same paths, to download puzzle inputs and submit answers.
+//
+// Downloaded inputs are cached on disk and never fetched twice, and requests
+// are spaced out by a minimum interval that holds across processes sharing
+// the cache directory.
+package remote
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Year is the event the puzzles in this repository belong to.
+const Year = 2025
+
+// DefaultBaseURL is the address of the Advent of Code website.
+const DefaultBaseURL = "https://adventofcode.com"
+
+// DefaultInterval is the minimum time between two requests.
+const DefaultInterval = 5 * time.Second
+
+// userAgent identifies the client to the website as its automation
+// guidelines ask.
+const userAgent = "github.com/janneh/advent-of-code-2025 aoc command"
+
+// lastRequestFile records the time of the last request in the cache
+// directory.
+const lastRequestFile = ".last-request"
+
+// ErrNoSession is returned when a request needs a session cookie and the
+// client has none.
+var ErrNoSession = errors.New("no session cookie, set AOC_SESSION or pass --session")
+
+// Client downloads puzzle inputs and submits answers.
+type Client struct {
+	// BaseURL is the address of the website, without a trailing slash.
+	BaseURL string
+	// Session is the value of the session cookie of a logged in user.
+	Session string
+	// CacheDir is the directory inputs are cached in.
+	CacheDir string
+	// Interval is the minimum time between two requests.
+	Interval time.Duration
+	// HTTPClient sends the requests, http.DefaultClient if nil.
+	HTTPClient *http.Client
+}
+
+// New returns a client for baseURL that caches inputs in cacheDir and
+// waits DefaultInterval between requests.
+func New(baseURL, session, cacheDir string) *Client {
+	return &Client{
+		BaseURL:  strings.TrimSuffix(baseURL, "/"),
+		Session:  session,
+		CacheDir: cacheDir,
+		Interval: DefaultInterval,
+	}
+}
+
+// Input returns the puzzle input of day, from the cache if it was downloaded
+// before. The second result reports whether it came from the cache.
+func (c *Client) Input(ctx context.Context, day int) ([]byte, bool, error) {
+	cached := c.InputPath(day)
+	if data, err := os.ReadFile(cached); err == nil {
+		return data, true, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, false, err
+	}
+
+	if c.Session == "" {
+		return nil, false, ErrNoSession
+	}
+	url := fmt.Sprintf("%s/%d/day/%d/input", c.BaseURL, Year, day)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	body, err := c.do(req)
+	if err != nil {
+		return nil, false, fmt.Errorf("fetching day %d input: %w", day, err)
+	}
+	if len(body) == 0 {
+		return nil, false, fmt.Errorf("fetching day %d input: empty response", day)
+	}
+
+	if err := writeFileAtomic(cached, body); err != nil {
+		return nil, false, err
+	}
+	return body, false, nil
+}
+
+// InputPath returns the file the input of day is cached in. Every user
+// gets their own input, so the inputs are kept apart per server and
+// session, under a hash of both that keeps the cookie out of the path.
+func (c *Client) InputPath(day int) string {
+	key := sha256.Sum256([]byte(c.BaseURL + "\n" + c.Session))
+	return filepath.Join(c.CacheDir, "inputs", hex.EncodeToString(key[:8]), fmt.Sprintf("day%02d.txt", day))
+}
+
+// do sends req once the rate limit allows and returns the body of a
+// successful response.
+func (c *Client) do(req *http.Request) ([]byte, error) {
+	if err := c.wait(req.Context()); err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		msg := strings.TrimSpace(string(body))
+		if len(msg) > 200 {
+			msg = msg[:200] + "..."
+		}
+		return nil, fmt.Errorf("%s: %s", resp.Status, msg)
+	}
+	return body, nil
+}
+
+// wait blocks until Interval has passed since the last request made with
+// the same cache directory, then records the current time as the last
+// request.
+func (c *Client) wait(ctx context.Context) error {
+	if err := os.MkdirAll(c.CacheDir, 0o755); err != nil {
+		return err
+	}
+	stamp := filepath.Join(c.CacheDir, lastRequestFile)
+	if info, err := os.Stat(stamp); err == nil {
+		if delay := c.Interval - time.Since(info.ModTime()); delay > 0 {
+			timer := time.NewTimer(delay)
+			defer timer.Stop()
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+
+	now := time.Now()
+	if err := os.WriteFile(stamp, nil, 0o644); err != nil {
+		return err
+	}
+	return os.Chtimes(stamp, now, now)
+}
+
+// writeFileAtomic writes data to name through a temporary file so an
+// interrupted download never leaves a partial input in the cache.
+func writeFileAtomic(name string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), name)
+}
@@ -0,0 +1,130 @@
+package remote_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/janneh/advent-of-code-2025/aoc/remote"
+	"github.com/janneh/advent-of-code-2025/aoc/remote/remotetest"
+)
+
+func newClient(t *testing.T, session string) (*remote.Client, *remotetest.Server) {
+	t.Helper()
+	srv := remotetest.NewServer(remote.Year, "secret", map[int]string{
+		1: "L68\nR48\n",
+		2: "11-22,95-115\n",
+	})
+	t.Cleanup(srv.Close)
+	c := remote.New(srv.URL, session, t.TempDir())
+	c.Interval = 0
+	return c, srv
+}
+
+func TestInputCached(t *testing.T) {
+	c, srv := newClient(t, "secret")
+	ctx := context.Background()
+
+	data, cached, err := c.Input(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "L68\nR48\n" || cached {
+		t.Errorf("first Input = %q, cached %v; want download", data, cached)
+	}
+
+	data, cached, err = c.Input(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "L68\nR48\n" || !cached {
+		t.Errorf("second Input = %q, cached %v; want cache hit", data, cached)
+	}
+	if got := srv.Requests(); got != 1 {
+		t.Errorf("server saw %d requests, want 1", got)
+	}
+
+	if _, err := os.Stat(c.InputPath(1)); err != nil {
+		t.Errorf("input not cached: %v", err)
+	}
+}
+
+func TestInputCachePerSession(t *testing.T) {
+	c, _ := newClient(t, "secret")
+	ctx := context.Background()
+	if _, _, err := c.Input(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// Another user or server sharing the cache directory must not get the
+	// input downloaded for the first one.
+	for _, other := range []*remote.Client{
+		remote.New(c.BaseURL, "wrong", c.CacheDir),
+		remote.New(c.BaseURL+"/mirror", "secret", c.CacheDir),
+	} {
+		other.Interval = 0
+		if other.InputPath(1) == c.InputPath(1) {
+			t.Errorf("%s with session %q shares the cache path %s", other.BaseURL, other.Session, c.InputPath(1))
+		}
+		if _, cached, _ := other.Input(ctx, 1); cached {
+			t.Errorf("%s with session %q got the cached input", other.BaseURL, other.Session)
+		}
+	}
+}
+
+func TestInputErrors(t *testing.T) {
+	ctx := context.Background()
+
+	c, srv := newClient(t, "")
+	if _, _, err := c.Input(ctx, 1); !errors.Is(err, remote.ErrNoSession) {
+		t.Errorf("Input without session: err = %v, want %v", err, remote.ErrNoSession)
+	}
+	if srv.Requests() != 0 {
+		t.Errorf("request sent without session")
+	}
+
+	c, _ = newClient(t, "wrong")
+	if _, _, err := c.Input(ctx, 1); err == nil || !strings.Contains(err.Error(), "400") {
+		t.Errorf("Input with wrong session: err = %v, want 400", err)
+	}
+
+	c, _ = newClient(t, "secret")
+	if _, _, err := c.Input(ctx, 25); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("Input of locked day: err = %v, want 404", err)
+	}
+	if _, err := os.Stat(c.InputPath(25)); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("failed download was cached")
+	}
+}
+
+func TestInputRateLimit(t *testing.T) {
+	c, _ := newClient(t, "secret")
+	c.Interval = 200 * time.Millisecond
+	ctx := context.Background()
+
+	start := time.Now()
+	for day := 1; day <= 2; day++ {
+		if _, _, err := c.Input(ctx, day); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < c.Interval {
+		t.Errorf("two downloads took %v, want at least %v", elapsed, c.Interval)
+	}
+
+	// The limit is shared through the cache directory, so a new client
+	// waits for the requests of the previous one.
+	other := remote.New(c.BaseURL, "secret", c.CacheDir)
+	other.Interval = time.Hour
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if err := os.Remove(c.InputPath(1)); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := other.Input(ctx, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Input within interval: err = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,128 @@
+// Package remotetest provides a stand-in for the Advent of Code website so
+// the remote client and the commands built on it can be tested offline.
+package remotetest
+
+import (
+	"fmt"
+	"html"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// Server serves puzzle inputs and judges submitted answers for requests
+// carrying the right session cookie, and counts the requests it receives.
+// Its responses mimic the pages of the real website.
+type Server struct {
+	*httptest.Server
+
+	// Session is the session cookie value the server accepts.
+	Session string
+
+	mu       sync.Mutex
+	inputs   map[int]string
+	answers  map[[2]int]string
+	solved   map[[2]int]bool
+	requests int
+}
+
+// NewServer starts a server for year that serves inputs by day and accepts
+// the session cookie session. Close it when done.
+func NewServer(year int, session string, inputs map[int]string) *Server {
+	s := &Server{
+		Session: session,
+		inputs:  inputs,
+		answers: make(map[[2]int]string),
+		solved:  make(map[[2]int]bool),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(fmt.Sprintf("GET /%d/day/{day}/input", year), s.serveInput)
+	mux.HandleFunc(fmt.Sprintf("POST /%d/day/{day}/answer", year), s.serveAnswer)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// SetAnswer sets the correct answer of part of day.
+func (s *Server) SetAnswer(day, part int, answer string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.answers[[2]int{day, part}] = answer
+}
+
+// Requests returns the number of requests served so far.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func (s *Server) serveInput(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+
+	if !s.authorized(r) {
+		http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+		return
+	}
+	var day int
+	if _, err := fmt.Sscan(r.PathValue("day"), &day); err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	input, ok := s.inputs[day]
+	if !ok {
+		http.Error(w, "Please don't repeatedly request this endpoint before it unlocks!", http.StatusNotFound)
+		return
+	}
+	fmt.Fprint(w, input)
+}
+
+func (s *Server) serveAnswer(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+
+	if !s.authorized(r) {
+		http.Error(w, "Please log in.", http.StatusBadRequest)
+		return
+	}
+	var day, part int
+	_, err1 := fmt.Sscan(r.PathValue("day"), &day)
+	_, err2 := fmt.Sscan(r.FormValue("level"), &part)
+	key := [2]int{day, part}
+	want, ok := s.answers[key]
+	if err1 != nil || err2 != nil || !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	answer := strings.TrimSpace(r.FormValue("answer"))
+	var msg string
+	switch {
+	case s.solved[key]:
+		msg = "You don't seem to be solving the right level.  Did you already complete it?"
+	case answer == want:
+		s.solved[key] = true
+		msg = "That's the right answer!  You are one gold star closer to decorating the North Pole."
+	default:
+		msg = "That's not the right answer."
+		got, okGot := new(big.Int).SetString(answer, 10)
+		exp, okExp := new(big.Int).SetString(want, 10)
+		if okGot && okExp {
+			if got.Cmp(exp) > 0 {
+				msg = "That's not the right answer; your answer is too high."
+			} else {
+				msg = "That's not the right answer; your answer is too low."
+			}
+		}
+	}
+	fmt.Fprintf(w, "<html><body><main>\n<article><p>%s</p></article>\n</main></body></html>\n", html.EscapeString(msg))
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	cookie, err := r.Cookie("session")
+	return err == nil && cookie.Value == s.Session
+}
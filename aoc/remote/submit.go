@@ -0,0 +1,89 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Verdict is the website's judgement of a submitted answer.
+type Verdict string
+
+// Verdicts recognised in the response to a submission.
+const (
+	Correct Verdict = "correct"
+	Wrong   Verdict = "wrong"
+	TooHigh Verdict = "too high"
+	TooLow  Verdict = "too low"
+	TooSoon Verdict = "too soon"
+	Solved  Verdict = "already solved"
+	Unknown Verdict = "unknown"
+)
+
+// Known reports whether v tells something about the answer itself, as
+// opposed to the submission not being judged.
+func (v Verdict) Known() bool {
+	switch v {
+	case Correct, Wrong, TooHigh, TooLow:
+		return true
+	}
+	return false
+}
+
+var (
+	articleRE = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagRE     = regexp.MustCompile(`<[^>]*>`)
+)
+
+// Submit posts answer for part of day and returns the verdict along with
+// the website's message.
+func (c *Client) Submit(ctx context.Context, day, part int, answer string) (Verdict, string, error) {
+	if c.Session == "" {
+		return "", "", ErrNoSession
+	}
+	form := url.Values{"level": {fmt.Sprint(part)}, "answer": {answer}}
+	u := fmt.Sprintf("%s/%d/day/%d/answer", c.BaseURL, Year, day)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	body, err := c.do(req)
+	if err != nil {
+		return "", "", fmt.Errorf("submitting day %d part %d: %w", day, part, err)
+	}
+	msg := message(string(body))
+	return verdict(msg), msg, nil
+}
+
+// message extracts the text of the article in a response page.
+func message(page string) string {
+	if m := articleRE.FindStringSubmatch(page); m != nil {
+		page = m[1]
+	}
+	text := html.UnescapeString(tagRE.ReplaceAllString(page, ""))
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func verdict(msg string) Verdict {
+	switch {
+	case strings.Contains(msg, "That's the right answer"):
+		return Correct
+	case strings.Contains(msg, "answer too recently"):
+		return TooSoon
+	case strings.Contains(msg, "solving the right level"):
+		return Solved
+	case strings.Contains(msg, "your answer is too high"):
+		return TooHigh
+	case strings.Contains(msg, "your answer is too low"):
+		return TooLow
+	case strings.Contains(msg, "not the right answer"):
+		return Wrong
+	}
+	return Unknown
+}
@@ -0,0 +1,101 @@
+package remote_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc/remote"
+)
+
+func TestSubmit(t *testing.T) {
+	c, srv := newClient(t, "secret")
+	srv.SetAnswer(1, 1, "3")
+	ctx := context.Background()
+
+	tests := []struct {
+		answer string
+		want   remote.Verdict
+	}{
+		{"5", remote.TooHigh},
+		{"2", remote.TooLow},
+		{"three", remote.Wrong},
+		{"3", remote.Correct},
+		{"3", remote.Solved},
+	}
+	for _, tt := range tests {
+		got, msg, err := c.Submit(ctx, 1, 1, tt.answer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Submit(%q) = %q (%q), want %q", tt.answer, got, msg, tt.want)
+		}
+	}
+}
+
+func TestCheckAnswer(t *testing.T) {
+	attempts := []remote.Attempt{
+		{Day: 1, Part: 1, Answer: "100", Verdict: remote.TooHigh},
+		{Day: 1, Part: 1, Answer: "10", Verdict: remote.TooLow},
+		{Day: 1, Part: 1, Answer: "50", Verdict: remote.Wrong},
+		{Day: 1, Part: 1, Answer: "60", Verdict: remote.TooSoon},
+		{Day: 2, Part: 1, Answer: "7", Verdict: remote.Correct},
+	}
+	tests := []struct {
+		day    int
+		answer string
+		ok     bool
+	}{
+		{1, "60", true}, // only rate limited before
+		{1, "99", true},
+		{1, "11", true},
+		{1, "50", false},
+		{1, "100", false},
+		{1, "150", false},
+		{1, "10", false},
+		{1, "-3", false},
+		{1, "abc", true},
+		{2, "8", false},
+		{3, "1", true},
+	}
+	for _, tt := range tests {
+		err := remote.CheckAnswer(attempts, tt.day, 1, tt.answer)
+		if tt.ok && err != nil {
+			t.Errorf("CheckAnswer(day %d, %q) = %v, want nil", tt.day, tt.answer, err)
+		}
+		if !tt.ok && !errors.Is(err, remote.ErrRejected) {
+			t.Errorf("CheckAnswer(day %d, %q) = %v, want %v", tt.day, tt.answer, err, remote.ErrRejected)
+		}
+	}
+}
+
+func TestLog(t *testing.T) {
+	filename := t.TempDir() + "/" + remote.LogFile
+	attempts, err := remote.ReadLog(filename)
+	if err != nil || len(attempts) != 0 {
+		t.Fatalf("ReadLog of missing log = %v, %v", attempts, err)
+	}
+
+	want := []remote.Attempt{
+		{Day: 1, Part: 1, Answer: "5", Verdict: remote.TooHigh},
+		{Day: 1, Part: 1, Answer: "3", Verdict: remote.Correct},
+	}
+	for _, a := range want {
+		if err := remote.AppendLog(filename, a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := remote.ReadLog(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ReadLog returned %d attempts, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].Answer != want[i].Answer || got[i].Verdict != want[i].Verdict {
+			t.Errorf("attempt %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
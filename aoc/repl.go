@@ -0,0 +1,194 @@
+package aoc
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Command is a REPL command of a day, run against the day's parsed input
+// to poke at it while debugging.
+type Command[T any] struct {
+	Name string
+	// Args describes the arguments, e.g. "<from> <to>".
+	Args  string
+	Usage string
+	// Func runs the command with the words typed after its name, writing
+	// what it finds to w.
+	Func func(ctx context.Context, w io.Writer, input T, args []string) error
+}
+
+var commands = make(map[int][]Command[any])
+
+// RegisterCommands adds REPL commands for day, whose parsed input is a T.
+// Like Register it is meant to be called from an init function and panics
+// if a command name is taken.
+func RegisterCommands[T any](day int, cmds ...Command[T]) {
+	for _, cmd := range cmds {
+		if cmd.Func == nil {
+			panic(fmt.Sprintf("aoc: RegisterCommands command %q for day %d has no Func", cmd.Name, day))
+		}
+		if builtins[cmd.Name] != "" {
+			panic(fmt.Sprintf("aoc: RegisterCommands command %q for day %d is a builtin", cmd.Name, day))
+		}
+		if _, dup := findCommand(day, cmd.Name); dup {
+			panic(fmt.Sprintf("aoc: RegisterCommands called twice for command %q of day %d", cmd.Name, day))
+		}
+		fn := cmd.Func
+		commands[day] = append(commands[day], Command[any]{
+			Name:  cmd.Name,
+			Args:  cmd.Args,
+			Usage: cmd.Usage,
+			Func: func(ctx context.Context, w io.Writer, input any, args []string) error {
+				typed, ok := input.(T)
+				if !ok {
+					return errors.New("input has wrong type for command")
+				}
+				return fn(ctx, w, typed, args)
+			},
+		})
+	}
+}
+
+// LookupCommands returns the REPL commands registered for day in the order
+// they were registered.
+func LookupCommands(day int) []Command[any] {
+	return commands[day]
+}
+
+func findCommand(day int, name string) (Command[any], bool) {
+	for _, cmd := range commands[day] {
+		if cmd.Name == name {
+			return cmd, true
+		}
+	}
+	return Command[any]{}, false
+}
+
+// builtins are the commands every day's REPL has, with their usage.
+var builtins = map[string]string{
+	"help":   "list the commands",
+	"part1":  "solve part 1",
+	"part2":  "solve part 2",
+	"reload": "read and parse the input again",
+	"quit":   "leave",
+	"exit":   "leave",
+}
+
+// REPL parses the named input for day once and then runs the commands read
+// from in, one per line, writing their output and a prompt to out. Besides
+// the day's own commands it knows the builtins help, part1, part2, reload
+// and quit. A failing command is reported and the REPL goes on; it returns
+// at the end of in, on quit or once ctx is done.
+func REPL(ctx context.Context, in io.Reader, out io.Writer, day int, filename string, opts Options) error {
+	s, ok := Lookup(day)
+	if !ok {
+		return fmt.Errorf("no solver registered for day %d", day)
+	}
+	loaded, err := parseInput(s, day, filename, opts)
+	if err != nil {
+		return err
+	}
+	input := loaded.input
+	fmt.Fprintf(out, "Loaded %s, type help for the commands of day %d\n", filename, day)
+
+	ctx = withObservers(ctx, opts)
+
+	prompt := fmt.Sprintf("day%02d> ", day)
+	scanner := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(out, prompt)
+		if ctx.Err() != nil || !scanner.Scan() {
+			fmt.Fprintln(out)
+			return scanner.Err()
+		}
+		words := strings.Fields(scanner.Text())
+		if len(words) == 0 {
+			continue
+		}
+
+		name, args := words[0], words[1:]
+		switch name {
+		case "help":
+			replHelp(out, day)
+		case "part1", "part2":
+			part, solve := 1, s.Part1
+			if name == "part2" {
+				part, solve = 2, s.Part2
+			}
+			start := time.Now()
+			answer, warnings, err := solvePart(ctx, day, part, solve, input, opts.Timeout)
+			elapsed := time.Since(start)
+			if err == nil {
+				err = checkOverflow(answer, opts.BigInt)
+			}
+			for _, warning := range warnings {
+				fmt.Fprintf(out, "warning: %s\n", warning)
+			}
+			if err != nil {
+				fmt.Fprintf(out, "error: %v (%v)\n", err, elapsed)
+			} else {
+				fmt.Fprintf(out, "%v (%v)\n", answer, elapsed)
+			}
+		case "reload":
+			reloaded, err := parseInput(s, day, filename, opts)
+			if err != nil {
+				fmt.Fprintf(out, "error: %v\n", err)
+				continue
+			}
+			input = reloaded.input
+			fmt.Fprintf(out, "Reloaded %s\n", filename)
+		case "quit", "exit":
+			return nil
+		default:
+			cmd, ok := findCommand(day, name)
+			if !ok {
+				fmt.Fprintf(out, "unknown command %q, type help for the list\n", name)
+				continue
+			}
+			if err := cmd.Func(ctx, out, input, args); err != nil {
+				fmt.Fprintf(out, "error: %v\n", err)
+			}
+		}
+	}
+}
+
+func replHelp(w io.Writer, day int) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, cmd := range LookupCommands(day) {
+		fmt.Fprintf(tw, "  %s %s\t%s\n", cmd.Name, cmd.Args, cmd.Usage)
+	}
+	for _, name := range []string{"part1", "part2", "reload", "help", "quit"} {
+		fmt.Fprintf(tw, "  %s\t%s\n", name, builtins[name])
+	}
+	tw.Flush()
+}
+
+// Nth parses arg as a position from 1 to n, such as the number of a line
+// of the input, and returns it as an index from 0.
+func Nth(arg string, n int) (int, error) {
+	i, err := strconv.Atoi(arg)
+	if err != nil || i < 1 || i > n {
+		return 0, fmt.Errorf("want a number from 1 to %d, got %q", n, arg)
+	}
+	return i - 1, nil
+}
+
+// ArgCount returns an error unless args holds exactly n words, for
+// commands to check their arguments with.
+func ArgCount(args []string, n int) error {
+	if len(args) != n {
+		noun := "arguments"
+		if n == 1 {
+			noun = "argument"
+		}
+		return fmt.Errorf("want %d %s, got %d", n, noun, len(args))
+	}
+	return nil
+}
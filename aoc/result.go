@@ -0,0 +1,161 @@
+package aoc
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Result is the outcome of solving one part of a day against one input.
+// A Result with Part 0 reports an input that could not be parsed.
+type Result struct {
+	Day         int           `json:"day"`
+	Part        int           `json:"part"`
+	Input       string        `json:"input"`
+	InputHash   string        `json:"input_hash,omitempty"`
+	Answer      string        `json:"answer,omitempty"`
+	Duration    time.Duration `json:"duration_ns"`
+	Diagnostics []string      `json:"diagnostics,omitempty"`
+	Err         string        `json:"error,omitempty"`
+	TimedOut    bool          `json:"timed_out,omitempty"`
+}
+
+// Output formats accepted by NewResultWriter.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// ResultWriter writes results in one of the output formats.
+type ResultWriter interface {
+	Write(r Result) error
+	Flush() error
+}
+
+// TextStyle selects how the text format lays out results.
+type TextStyle int
+
+const (
+	// TextPlain prints the familiar "Part 1: answer" lines.
+	TextPlain TextStyle = iota
+	// TextByInput groups the plain lines under the name of their input.
+	TextByInput
+	// TextByDay prints one "Day N Part P: answer (duration)" line per result.
+	TextByDay
+)
+
+// NewResultWriter returns a writer for format. The text format lays out
+// results according to style; json writes one object per line and csv a
+// header followed by one row per result.
+func NewResultWriter(w io.Writer, format string, style TextStyle) (ResultWriter, error) {
+	switch format {
+	case FormatText, "":
+		return &textWriter{w: w, style: style}, nil
+	case FormatJSON:
+		return &jsonWriter{enc: json.NewEncoder(w)}, nil
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	}
+	return nil, fmt.Errorf("unknown output format %q", format)
+}
+
+type textWriter struct {
+	w         io.Writer
+	style     TextStyle
+	lastInput string
+	written   bool
+}
+
+func (t *textWriter) Write(r Result) error {
+	if t.style == TextByDay {
+		return t.writeByDay(r)
+	}
+
+	if t.style == TextByInput && (!t.written || r.Input != t.lastInput) {
+		if t.written {
+			fmt.Fprintln(t.w)
+		}
+		fmt.Fprintf(t.w, "== %s ==\n", filepath.Base(r.Input))
+	}
+	t.written = true
+	t.lastInput = r.Input
+
+	var err error
+	switch {
+	case r.Err != "" && r.Part == 0:
+		_, err = fmt.Fprintf(t.w, "Error: %s\n", r.Err)
+	case r.TimedOut:
+		_, err = fmt.Fprintf(t.w, "Part %d: %s\n", r.Part, r.Err)
+	case r.Err != "":
+		_, err = fmt.Fprintf(t.w, "Part %d: error: %s\n", r.Part, r.Err)
+	default:
+		_, err = fmt.Fprintf(t.w, "Part %d: %s\n", r.Part, r.Answer)
+	}
+	return err
+}
+
+func (t *textWriter) writeByDay(r Result) error {
+	var err error
+	switch {
+	case r.Err != "" && r.Part == 0:
+		_, err = fmt.Fprintf(t.w, "Day %2d:        error: %s\n", r.Day, r.Err)
+	case r.TimedOut:
+		_, err = fmt.Fprintf(t.w, "Day %2d Part %d: %s\n", r.Day, r.Part, r.Err)
+	case r.Err != "":
+		_, err = fmt.Fprintf(t.w, "Day %2d Part %d: error: %s (%v)\n", r.Day, r.Part, r.Err, r.Duration)
+	default:
+		_, err = fmt.Fprintf(t.w, "Day %2d Part %d: %s (%v)\n", r.Day, r.Part, r.Answer, r.Duration)
+	}
+	return err
+}
+
+func (t *textWriter) Flush() error {
+	return nil
+}
+
+type jsonWriter struct {
+	enc *json.Encoder
+}
+
+func (j *jsonWriter) Write(r Result) error {
+	return j.enc.Encode(r)
+}
+
+func (j *jsonWriter) Flush() error {
+	return nil
+}
+
+type csvWriter struct {
+	w       *csv.Writer
+	started bool
+}
+
+func (c *csvWriter) Write(r Result) error {
+	if !c.started {
+		c.started = true
+		if err := c.w.Write([]string{"day", "part", "input", "answer", "duration_ns", "error", "timed_out", "diagnostics"}); err != nil {
+			return err
+		}
+	}
+	return c.w.Write([]string{
+		strconv.Itoa(r.Day),
+		strconv.Itoa(r.Part),
+		r.Input,
+		r.Answer,
+		strconv.FormatInt(int64(r.Duration), 10),
+		r.Err,
+		strconv.FormatBool(r.TimedOut),
+		strings.Join(r.Diagnostics, "; "),
+	})
+}
+
+func (c *csvWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
@@ -0,0 +1,377 @@
+package aoc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"runtime/pprof"
+	"runtime/trace"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/janneh/advent-of-code-2025/aoc/checked"
+	"github.com/janneh/advent-of-code-2025/aoc/history"
+)
+
+// Options control how a day is run.
+type Options struct {
+	// Part selects the part to solve, 0 runs every part the day provides.
+	Part int
+	// Strict fails on the first problem in the input instead of warning
+	// about it on stderr.
+	Strict bool
+	// Format is the output format, see NewResultWriter.
+	Format string
+	// Timeout limits the time spent on each part, 0 means no limit.
+	Timeout time.Duration
+	// Progress receives the progress events of the solvers, nil drops
+	// them.
+	Progress Progress
+	// Tracer receives the steps of the solvers in explain mode, nil turns
+	// explain mode off.
+	Tracer Tracer
+	// BigInt accepts answers too large for an int, which solvers return
+	// as checked.Int. Without it such answers are overflow errors.
+	BigInt bool
+	// History is the run history every solved part is appended to, see
+	// package history. Empty keeps no history.
+	History string
+}
+
+// Solve parses the named input for day and returns the result of every
+// requested part. Parse warnings are printed to stderr and attached to each
+// result, as are the warnings a solver reports with Warnf to the result of
+// its part. The name Stdin reads the input from standard input.
+//
+// A part that runs past opts.Timeout gets a result marked TimedOut and the
+// remaining parts still run. Once ctx is canceled, as by Ctrl-C, the part
+// running and those after it fail with "canceled".
+func Solve(ctx context.Context, day int, filename string, opts Options) ([]Result, error) {
+	s, ok := Lookup(day)
+	if !ok {
+		return nil, fmt.Errorf("no solver registered for day %d", day)
+	}
+
+	in, err := parseInput(s, day, filename, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withObservers(ctx, opts)
+	var results []Result
+	for part := 1; part <= 2; part++ {
+		if opts.Part != 0 && opts.Part != part {
+			continue
+		}
+		res, err := solveParsed(ctx, s, day, part, filename, in, opts)
+		if errors.Is(err, ErrNoPart) && opts.Part == 0 {
+			continue
+		}
+		results = append(results, res)
+	}
+
+	if opts.History != "" {
+		if err := record(opts.History, results); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: recording run history: %v\n", err)
+		}
+	}
+	return results, nil
+}
+
+// parsed is a parsed input along with its warnings and hash.
+type parsed struct {
+	input    any
+	warnings []string
+	hash     string
+}
+
+// withObservers returns a copy of ctx carrying the progress renderer and
+// tracer of opts.
+func withObservers(ctx context.Context, opts Options) context.Context {
+	if opts.Progress != nil {
+		ctx = WithProgress(ctx, opts.Progress)
+	}
+	if opts.Tracer != nil {
+		ctx = WithTracer(ctx, opts.Tracer)
+	}
+	return ctx
+}
+
+// solveParsed solves one part of the parsed input of day and returns its
+// result, along with the error the part failed with.
+func solveParsed(ctx context.Context, s Solver, day, part int, filename string, in parsed, opts Options) (Result, error) {
+	solve := s.Part1
+	if part == 2 {
+		solve = s.Part2
+	}
+	start := time.Now()
+	answer, solverWarnings, err := solvePart(ctx, day, part, solve, in.input, opts.Timeout)
+	elapsed := time.Since(start)
+	if err == nil {
+		err = checkOverflow(answer, opts.BigInt)
+	}
+	for _, warning := range solverWarnings {
+		fmt.Fprintf(os.Stderr, "warning: day %d part %d: %s\n", day, part, warning)
+	}
+
+	res := Result{
+		Day:         day,
+		Part:        part,
+		Input:       inputName(filename),
+		InputHash:   in.hash,
+		Duration:    elapsed,
+		Diagnostics: append(slices.Clip(in.warnings), solverWarnings...),
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		res.TimedOut = true
+		res.Err = fmt.Sprintf("timed out after %v", elapsed.Round(time.Millisecond))
+	case errors.Is(err, context.Canceled):
+		res.Err = "canceled"
+	case err != nil:
+		res.Err = err.Error()
+	default:
+		res.Answer = fmt.Sprint(answer)
+	}
+	return res, err
+}
+
+// checkOverflow returns an error wrapping checked.ErrOverflow for an
+// answer that does not fit in an int, unless bigint allows it.
+func checkOverflow(answer any, bigint bool) error {
+	if n, ok := answer.(checked.Int); ok && !n.IsInt() && !bigint {
+		return fmt.Errorf("%w: the answer does not fit in an int, rerun with --bigint", checked.ErrOverflow)
+	}
+	return nil
+}
+
+// record appends results to the run history in filename.
+func record(filename string, results []Result) error {
+	runs := make([]history.Run, len(results))
+	for i, res := range results {
+		runs[i] = history.Run{
+			Day:       res.Day,
+			Part:      res.Part,
+			Input:     res.Input,
+			InputHash: res.InputHash,
+			Answer:    res.Answer,
+			Err:       res.Err,
+			Duration:  res.Duration,
+			Revision:  history.Revision(),
+			Time:      time.Now().UTC(),
+		}
+	}
+	return history.Append(filename, runs...)
+}
+
+// parseInput reads and parses the named input for day, printing its
+// warnings to stderr.
+func parseInput(s Solver, day int, filename string, opts Options) (parsed, error) {
+	file, err := OpenInput(filename)
+	if err != nil {
+		return parsed{}, err
+	}
+	data, err := io.ReadAll(file)
+	file.Close()
+	if err != nil {
+		return parsed{}, err
+	}
+
+	diag := &Diagnostics{File: inputName(filename), Strict: opts.Strict}
+	input, err := s.Parse(bytes.NewReader(data), diag)
+	var warnings []string
+	for _, warning := range diag.Warnings {
+		fmt.Fprintf(os.Stderr, "warning: %v\n", warning)
+		warnings = append(warnings, warning.Error())
+	}
+	if err != nil {
+		return parsed{}, fmt.Errorf("parsing day %d input: %w", day, err)
+	}
+	return parsed{input, warnings, history.Hash(data)}, nil
+}
+
+// inputName is how results and diagnostics refer to the named input.
+func inputName(filename string) string {
+	if filename == Stdin {
+		return "<stdin>"
+	}
+	return filename
+}
+
+// solvePart runs solve with the given timeout, labelled with day and part
+// for profiles and traces, and returns the warnings it reported with Warnf.
+// Solvers are expected to return once their context
+// is done; one that does not is left running in the background so the caller
+// can move on.
+func solvePart(ctx context.Context, day, part int, solve func(context.Context, any) (any, error), input any, timeout time.Duration) (any, []string, error) {
+	ctx = withPart(ctx, day, part)
+	defer Report(ctx, Event{Phase: PhaseDone})
+	warnings := &partWarnings{}
+	ctx = context.WithValue(ctx, warningsKey{}, warnings)
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	type outcome struct {
+		answer any
+		err    error
+	}
+	done := make(chan outcome, 1)
+	labels := pprof.Labels("day", strconv.Itoa(day), "part", strconv.Itoa(part))
+	go pprof.Do(ctx, labels, func(ctx context.Context) {
+		defer trace.StartRegion(ctx, fmt.Sprintf("day %d part %d", day, part)).End()
+		answer, err := solve(ctx, input)
+		done <- outcome{answer, err}
+	})
+
+	select {
+	case o := <-done:
+		return o.answer, warnings.warnings(), o.err
+	case <-ctx.Done():
+		return nil, warnings.warnings(), ctx.Err()
+	}
+}
+
+// Run solves the named input for day and writes the results to w in the
+// format chosen by opts.
+func Run(ctx context.Context, w io.Writer, day int, filename string, opts Options) error {
+	out, err := NewResultWriter(w, opts.Format, TextPlain)
+	if err != nil {
+		return err
+	}
+
+	results, err := Solve(ctx, day, filename, opts)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, res := range results {
+		if res.Err != "" {
+			failed++
+		}
+		if err := out.Write(res); err != nil {
+			return err
+		}
+	}
+	if err := out.Flush(); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d parts failed", failed, len(results))
+	}
+	return nil
+}
+
+// RunDir runs day against every input file in dir, writing the results of
+// each file under its name. A failing file is reported and does not stop the
+// remaining ones; the returned error counts the failures.
+func RunDir(ctx context.Context, w io.Writer, day int, dir string, opts Options) error {
+	out, err := NewResultWriter(w, opts.Format, TextByInput)
+	if err != nil {
+		return err
+	}
+
+	files, err := InputFiles(dir)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no input files in %s", dir)
+	}
+
+	failed := 0
+	for _, filename := range files {
+		results, err := Solve(ctx, day, filename, opts)
+		if err != nil {
+			results = []Result{{Day: day, Input: filename, Err: err.Error()}}
+		}
+
+		fileFailed := false
+		for _, res := range results {
+			fileFailed = fileFailed || res.Err != ""
+			if err := out.Write(res); err != nil {
+				return err
+			}
+		}
+		if fileFailed {
+			failed++
+		}
+	}
+	if err := out.Flush(); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d inputs failed", failed, len(files))
+	}
+	return nil
+}
+
+// Main runs every part of day and backs the per-day commands. It reads
+// input.txt in the working directory unless the -input or -inputs flag says
+// otherwise, and exits the process on error.
+func Main(day int) {
+	var opts Options
+	input := flag.String("input", "input.txt", "input file, or - for stdin")
+	inputs := flag.String("inputs", "", "directory of input files to solve one by one")
+	flag.BoolVar(&opts.Strict, "strict", false, "fail on the first problem in the input")
+	flag.StringVar(&opts.Format, "format", FormatText, "output format: text, json or csv")
+	flag.DurationVar(&opts.Timeout, "timeout", 0, "time limit per part, e.g. 30s (0 for none)")
+	progress := flag.String("progress", ProgressAuto, "progress on stderr: auto, bar, json or none")
+	explain := flag.String("explain", "", "trace every solver step to stderr as text or json")
+	flag.BoolVar(&opts.BigInt, "bigint", false, "allow answers too large for 64 bits")
+	var profile Profile
+	profile.RegisterFlags(flag.CommandLine)
+	var hist history.Flags
+	hist.RegisterFlags(flag.CommandLine)
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var err error
+	if opts.Progress, err = NewProgress(os.Stderr, *progress); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if *explain != "" {
+		if opts.Tracer, err = NewTracer(os.Stderr, *explain); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	if opts.History, err = hist.File(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	stopProfile, err := profile.Start()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if *inputs != "" {
+		err = RunDir(ctx, os.Stdout, day, *inputs, opts)
+	} else {
+		err = Run(ctx, os.Stdout, day, *input, opts)
+	}
+	err = errors.Join(err, stopProfile())
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
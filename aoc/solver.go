@@ -0,0 +1,71 @@
+// Package aoc provides the solver registry shared by every day of the
+// calendar and the helpers used to run them.
+package aoc
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNoPart is returned by a Solver for a part the puzzle does not have.
+var ErrNoPart = errors.New("part not available")
+
+// Solver is implemented by every day: it parses the raw puzzle input once
+// and solves both parts from the parsed value. Parse reports problems in
+// the input to diag, which may be nil. Long-running parts stop with the
+// context's error once ctx is done. Parts must not modify the parsed input,
+// which both parts share and RunAll solves them from at once.
+type Solver interface {
+	Parse(r io.Reader, diag *Diagnostics) (any, error)
+	Part1(ctx context.Context, input any) (any, error)
+	Part2(ctx context.Context, input any) (any, error)
+}
+
+// Puzzle adapts typed parse and solve functions to the Solver interface.
+// A nil Part2Func reports ErrNoPart.
+type Puzzle[T any] struct {
+	ParseFunc func(r io.Reader, diag *Diagnostics) (T, error)
+	Part1Func func(ctx context.Context, input T) (any, error)
+	Part2Func func(ctx context.Context, input T) (any, error)
+}
+
+func (p Puzzle[T]) Parse(r io.Reader, diag *Diagnostics) (any, error) {
+	return p.ParseFunc(r, diag)
+}
+
+func (p Puzzle[T]) Part1(ctx context.Context, input any) (any, error) {
+	return solve(ctx, p.Part1Func, input)
+}
+
+func (p Puzzle[T]) Part2(ctx context.Context, input any) (any, error) {
+	return solve(ctx, p.Part2Func, input)
+}
+
+// Parts reports how many parts the puzzle has.
+func (p Puzzle[T]) Parts() int {
+	if p.Part2Func == nil {
+		return 1
+	}
+	return 2
+}
+
+// NumParts returns the number of parts s provides. Solvers that do not
+// report it themselves are assumed to have both parts.
+func NumParts(s Solver) int {
+	if p, ok := s.(interface{ Parts() int }); ok {
+		return p.Parts()
+	}
+	return 2
+}
+
+func solve[T any](ctx context.Context, fn func(context.Context, T) (any, error), input any) (any, error) {
+	if fn == nil {
+		return nil, ErrNoPart
+	}
+	typed, ok := input.(T)
+	if !ok {
+		return nil, errors.New("input has wrong type for solver")
+	}
+	return fn(ctx, typed)
+}
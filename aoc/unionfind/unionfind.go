@@ -0,0 +1,232 @@
+// Package unionfind provides a disjoint-set forest over arbitrary keys.
+package unionfind
+
+import "fmt"
+
+// DisjointSet partitions keys into components that can be merged. Keys
+// join the set as singletons the first time they are added or used.
+//
+// A set made with NewRollback can undo its changes back to a snapshot, for
+// offline algorithms that try a merge and take it back. It skips path
+// compression so merges stay undoable, keeping finds logarithmic through
+// union by size. A set made with New compresses paths and cannot roll back.
+type DisjointSet[K comparable] struct {
+	index  map[K]int
+	keys   []K
+	parent []int
+	size   []int
+	count  int
+
+	rollback bool
+	history  []change
+}
+
+// change records one undoable step: adding key index added, or, when added
+// is -1, attaching root child under root parent.
+type change struct {
+	added         int
+	child, parent int
+}
+
+// New returns an empty set that compresses paths.
+func New[K comparable]() *DisjointSet[K] {
+	return &DisjointSet[K]{index: make(map[K]int)}
+}
+
+// NewRollback returns an empty set that supports Snapshot and Rollback.
+func NewRollback[K comparable]() *DisjointSet[K] {
+	s := New[K]()
+	s.rollback = true
+	return s
+}
+
+// Len returns the number of keys in the set.
+func (s *DisjointSet[K]) Len() int {
+	return len(s.keys)
+}
+
+// Count returns the number of components.
+func (s *DisjointSet[K]) Count() int {
+	return s.count
+}
+
+// Add adds k as a singleton component, reporting whether it was new.
+func (s *DisjointSet[K]) Add(k K) bool {
+	if _, ok := s.index[k]; ok {
+		return false
+	}
+	s.id(k)
+	return true
+}
+
+// id returns the index of k, adding it first if needed.
+func (s *DisjointSet[K]) id(k K) int {
+	if i, ok := s.index[k]; ok {
+		return i
+	}
+	i := len(s.keys)
+	s.index[k] = i
+	s.keys = append(s.keys, k)
+	s.parent = append(s.parent, i)
+	s.size = append(s.size, 1)
+	s.count++
+	if s.rollback {
+		s.history = append(s.history, change{added: i})
+	}
+	return i
+}
+
+// root returns the index of the root of the component containing index i.
+func (s *DisjointSet[K]) root(i int) int {
+	r := i
+	for s.parent[r] != r {
+		r = s.parent[r]
+	}
+	if !s.rollback {
+		for s.parent[i] != r {
+			s.parent[i], i = r, s.parent[i]
+		}
+	}
+	return r
+}
+
+// Find returns the representative key of the component containing k.
+func (s *DisjointSet[K]) Find(k K) K {
+	return s.keys[s.root(s.id(k))]
+}
+
+// Union merges the components of a and b, reporting whether they were
+// separate.
+func (s *DisjointSet[K]) Union(a, b K) bool {
+	ra, rb := s.root(s.id(a)), s.root(s.id(b))
+	if ra == rb {
+		return false
+	}
+
+	// Union by size
+	if s.size[ra] < s.size[rb] {
+		ra, rb = rb, ra
+	}
+	s.parent[rb] = ra
+	s.size[ra] += s.size[rb]
+	s.count--
+	if s.rollback {
+		s.history = append(s.history, change{added: -1, child: rb, parent: ra})
+	}
+	return true
+}
+
+// Connected reports whether a and b are in the same component. Keys not
+// in the set are only connected to themselves.
+func (s *DisjointSet[K]) Connected(a, b K) bool {
+	ia, okA := s.index[a]
+	ib, okB := s.index[b]
+	if !okA || !okB {
+		return a == b
+	}
+	return s.root(ia) == s.root(ib)
+}
+
+// Size returns the number of keys in the component containing k, 0 if k
+// is not in the set.
+func (s *DisjointSet[K]) Size(k K) int {
+	i, ok := s.index[k]
+	if !ok {
+		return 0
+	}
+	return s.size[s.root(i)]
+}
+
+// Sizes returns the size of every component, in order of their first key.
+func (s *DisjointSet[K]) Sizes() []int {
+	sizes := make([]int, 0, s.count)
+	for i := range s.keys {
+		if s.parent[i] == i {
+			sizes = append(sizes, s.size[i])
+		}
+	}
+	return sizes
+}
+
+// Components returns the members of every component. Components are in
+// order of their first key and members in the order they were added.
+func (s *DisjointSet[K]) Components() [][]K {
+	slot := make(map[int]int, s.count)
+	components := make([][]K, 0, s.count)
+	for i, k := range s.keys {
+		r := s.root(i)
+		j, ok := slot[r]
+		if !ok {
+			j = len(components)
+			slot[r] = j
+			components = append(components, make([]K, 0, s.size[r]))
+		}
+		components[j] = append(components[j], k)
+	}
+	return components
+}
+
+// Members returns the keys in the component containing k in the order they
+// were added, or nil if k is not in the set.
+func (s *DisjointSet[K]) Members(k K) []K {
+	i, ok := s.index[k]
+	if !ok {
+		return nil
+	}
+	r := s.root(i)
+	members := make([]K, 0, s.size[r])
+	for j, key := range s.keys {
+		if s.root(j) == r {
+			members = append(members, key)
+		}
+	}
+	return members
+}
+
+// Snapshot returns a point Rollback can return the set to. It panics if
+// the set was not made with NewRollback.
+func (s *DisjointSet[K]) Snapshot() int {
+	s.mustRollback("Snapshot")
+	return len(s.history)
+}
+
+// Rollback undoes every Add and Union made since snapshot was taken.
+func (s *DisjointSet[K]) Rollback(snapshot int) {
+	s.mustRollback("Rollback")
+	if snapshot < 0 || snapshot > len(s.history) {
+		panic(fmt.Sprintf("unionfind: Rollback to unknown snapshot %d", snapshot))
+	}
+	for len(s.history) > snapshot {
+		s.Undo()
+	}
+}
+
+// Undo undoes the last Add or Union that changed the set, reporting
+// whether there was one.
+func (s *DisjointSet[K]) Undo() bool {
+	s.mustRollback("Undo")
+	if len(s.history) == 0 {
+		return false
+	}
+	c := s.history[len(s.history)-1]
+	s.history = s.history[:len(s.history)-1]
+
+	if c.added >= 0 {
+		delete(s.index, s.keys[c.added])
+		s.keys = s.keys[:c.added]
+		s.parent = s.parent[:c.added]
+		s.size = s.size[:c.added]
+		s.count--
+	} else {
+		s.parent[c.child] = c.child
+		s.size[c.parent] -= s.size[c.child]
+		s.count++
+	}
+	return true
+}
+
+func (s *DisjointSet[K]) mustRollback(method string) {
+	if !s.rollback {
+		panic("unionfind: " + method + " on a set not made with NewRollback")
+	}
+}
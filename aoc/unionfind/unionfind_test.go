@@ -0,0 +1,106 @@
+package unionfind
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestUnion(t *testing.T) {
+	s := New[string]()
+	for _, k := range []string{"a", "b", "c", "d", "e"} {
+		s.Add(k)
+	}
+	if s.Add("a") {
+		t.Errorf("Add of an existing key reported new")
+	}
+
+	if !s.Union("a", "b") || !s.Union("c", "d") || !s.Union("b", "d") {
+		t.Fatalf("Union of separate components reported false")
+	}
+	if s.Union("a", "c") {
+		t.Errorf("Union within a component reported true")
+	}
+
+	if got := s.Count(); got != 2 {
+		t.Errorf("Count = %d, want 2", got)
+	}
+	if got := s.Size("c"); got != 4 {
+		t.Errorf("Size(c) = %d, want 4", got)
+	}
+	if s.Find("a") != s.Find("d") || s.Find("a") == s.Find("e") {
+		t.Errorf("Find disagrees with the unions")
+	}
+	if !s.Connected("b", "c") || s.Connected("a", "e") || s.Connected("a", "z") {
+		t.Errorf("Connected disagrees with the unions")
+	}
+
+	want := [][]string{{"a", "b", "c", "d"}, {"e"}}
+	if got := s.Components(); !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("Components = %v, want %v", got, want)
+	}
+	if got := s.Members("d"); !slices.Equal(got, want[0]) {
+		t.Errorf("Members(d) = %v, want %v", got, want[0])
+	}
+	if got := s.Sizes(); !slices.Equal(got, []int{4, 1}) {
+		t.Errorf("Sizes = %v, want [4 1]", got)
+	}
+
+	// Union adds unknown keys.
+	s.Union("e", "f")
+	if s.Len() != 6 || s.Count() != 2 {
+		t.Errorf("Len, Count = %d, %d after union with a new key, want 6, 2", s.Len(), s.Count())
+	}
+}
+
+func TestLongChain(t *testing.T) {
+	s := New[int]()
+	const n = 1 << 20
+	for i := 1; i < n; i++ {
+		s.Union(i, i-1)
+	}
+	if s.Count() != 1 || s.Size(n-1) != n {
+		t.Errorf("Count, Size = %d, %d, want 1, %d", s.Count(), s.Size(n-1), n)
+	}
+}
+
+func TestRollback(t *testing.T) {
+	s := NewRollback[int]()
+	s.Union(1, 2)
+	snap := s.Snapshot()
+
+	s.Union(2, 3)
+	s.Union(4, 5)
+	s.Union(1, 5)
+	if s.Count() != 1 || s.Len() != 5 {
+		t.Fatalf("Count, Len = %d, %d, want 1, 5", s.Count(), s.Len())
+	}
+
+	if !s.Undo() {
+		t.Fatalf("Undo reported nothing to undo")
+	}
+	if s.Connected(1, 5) || !s.Connected(4, 5) {
+		t.Errorf("Undo did not take back only the last union")
+	}
+
+	s.Rollback(snap)
+	if s.Count() != 1 || s.Len() != 2 || !s.Connected(1, 2) {
+		t.Errorf("after Rollback: Count, Len = %d, %d, want 1, 2", s.Count(), s.Len())
+	}
+	if s.Size(3) != 0 {
+		t.Errorf("key added after the snapshot is still in the set")
+	}
+
+	s.Rollback(0)
+	if s.Len() != 0 || s.Count() != 0 || s.Undo() {
+		t.Errorf("Rollback(0) did not empty the set")
+	}
+}
+
+func TestRollbackNeedsNewRollback(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Snapshot on a compressing set did not panic")
+		}
+	}()
+	New[int]().Snapshot()
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+func benchCmd(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	day := fs.Int("day", 0, "day to benchmark (0 for every day)")
+	input := fs.String("input", "", "input file (default dayNN/input.txt, requires --day)")
+	jsonOut := fs.String("json", "", "write the report as JSON to this file")
+	compare := fs.String("compare", "", "compare against a previous JSON report")
+	fs.Parse(args)
+
+	if *input != "" && *day == 0 {
+		return fmt.Errorf("--input requires --day")
+	}
+
+	var base *aoctest.BenchReport
+	if *compare != "" {
+		var err error
+		base, err = aoctest.ReadBenchReport(*compare)
+		if err != nil {
+			return err
+		}
+	}
+
+	days := aoc.Days()
+	if *day != 0 {
+		days = []int{*day}
+	}
+
+	report := aoctest.NewBenchReport()
+	for _, d := range days {
+		filename := *input
+		if filename == "" {
+			filename = aoc.DefaultInput(d)
+		}
+		fmt.Fprintf(os.Stderr, "benchmarking day %d...\n", d)
+		if err := report.Bench(d, filename); err != nil {
+			return err
+		}
+	}
+
+	if err := report.WriteTable(os.Stdout, base); err != nil {
+		return err
+	}
+
+	if *jsonOut != "" {
+		file, err := os.Create(*jsonOut)
+		if err != nil {
+			return err
+		}
+		if err := report.WriteJSON(file); err != nil {
+			file.Close()
+			return err
+		}
+		return file.Close()
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/cache"
+	"github.com/janneh/advent-of-code-2025/aoc/remote"
+)
+
+// remoteFlags adds the flags shared by the commands that talk to the
+// website and returns a function building the client from them.
+func remoteFlags(fs *flag.FlagSet) func() (*remote.Client, error) {
+	baseURL := fs.String("url", envOr("AOC_URL", remote.DefaultBaseURL), "base URL of the puzzle website (env AOC_URL)")
+	session := fs.String("session", "", "session cookie (default from env AOC_SESSION)")
+	cacheDir := fs.String("cache", "", "cache directory (default per-user cache directory)")
+	interval := fs.Duration("interval", remote.DefaultInterval, "minimum time between requests")
+	return func() (*remote.Client, error) {
+		if *session == "" {
+			*session = os.Getenv("AOC_SESSION")
+		}
+		if *cacheDir == "" {
+			dir, err := cache.UserDir()
+			if err != nil {
+				return nil, err
+			}
+			*cacheDir = dir
+		}
+		c := remote.New(*baseURL, *session, *cacheDir)
+		c.Interval = *interval
+		return c, nil
+	}
+}
+
+// checkDay returns an error unless day has a registered solver, so fetch
+// and submit accept the same days as run: the twelve of the calendar and
+// any added since with aoc new.
+func checkDay(day int) error {
+	if day == 0 {
+		return fmt.Errorf("--day is required")
+	}
+	if _, ok := aoc.Lookup(day); !ok {
+		return fmt.Errorf("no solver registered for day %d", day)
+	}
+	return nil
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func fetchCmd(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("fetch", flag.ExitOnError)
+	day := fs.Int("day", 0, "day to fetch (1-12)")
+	out := fs.String("out", "", "file to write the input to (default dayNN/input.txt)")
+	force := fs.Bool("force", false, "overwrite an existing input file")
+	client := remoteFlags(fs)
+	fs.Parse(args)
+
+	if err := checkDay(*day); err != nil {
+		return err
+	}
+	if *out == "" {
+		*out = aoc.DefaultInput(*day)
+	}
+	if _, err := os.Stat(*out); err == nil && !*force {
+		fmt.Fprintf(os.Stderr, "%s already exists, use --force to overwrite it\n", *out)
+		return nil
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	c, err := client()
+	if err != nil {
+		return err
+	}
+	data, cached, err := c.Input(ctx, *day)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(*out), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(*out, data, 0o644); err != nil {
+		return err
+	}
+
+	from := "downloaded"
+	if cached {
+		from = "from cache"
+	}
+	fmt.Fprintf(os.Stderr, "wrote %s (%d bytes, %s)\n", *out, len(data), from)
+	return nil
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc/remote"
+	"github.com/janneh/advent-of-code-2025/aoc/remote/remotetest"
+)
+
+func TestFetch(t *testing.T) {
+	srv := remotetest.NewServer(remote.Year, "secret", map[int]string{3: "987654321111111\n"})
+	defer srv.Close()
+
+	dir := t.TempDir()
+	out := filepath.Join(dir, "day03", "input.txt")
+	args := []string{
+		"--day", "3", "--out", out,
+		"--url", srv.URL, "--session", "secret",
+		"--cache", filepath.Join(dir, "cache"), "--interval", "0",
+	}
+	ctx := context.Background()
+
+	if err := fetchCmd(ctx, args); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "987654321111111\n" {
+		t.Errorf("fetched input = %q", data)
+	}
+
+	// A second fetch leaves the file alone and a forced one is served
+	// from the cache.
+	if err := fetchCmd(ctx, args); err != nil {
+		t.Fatal(err)
+	}
+	if err := fetchCmd(ctx, append(args, "--force")); err != nil {
+		t.Fatal(err)
+	}
+	if got := srv.Requests(); got != 1 {
+		t.Errorf("server saw %d requests, want 1", got)
+	}
+}
+
+func TestRemoteDays(t *testing.T) {
+	srv := remotetest.NewServer(remote.Year, "secret", map[int]string{13: "x\n"})
+	defer srv.Close()
+
+	dir := t.TempDir()
+	remoteArgs := []string{
+		"--url", srv.URL, "--session", "secret",
+		"--cache", filepath.Join(dir, "cache"), "--interval", "0",
+	}
+	ctx := context.Background()
+
+	// fetch and submit accept the same days, those with a solver.
+	for _, day := range []string{"0", "13", "25"} {
+		args := append([]string{"--day", day, "--out", filepath.Join(dir, "input.txt")}, remoteArgs...)
+		if err := fetchCmd(ctx, args); err == nil {
+			t.Errorf("fetch --day %s: no error", day)
+		}
+		args = append([]string{"--day", day, "--part", "1", "--answer", "1"}, remoteArgs...)
+		if err := submitCmd(ctx, args); err == nil {
+			t.Errorf("submit --day %s: no error", day)
+		}
+	}
+	if got := srv.Requests(); got != 0 {
+		t.Errorf("server saw %d requests, want 0", got)
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+func genCmd(args []string) error {
+	fs := flag.NewFlagSet("gen", flag.ExitOnError)
+	day := fs.Int("day", 0, "day to generate an input for")
+	size := fs.Int("size", 0, "size of the input, see --params (default per day)")
+	seed := fs.Uint64("seed", 1, "random seed; the same seed gives the same input")
+	out := fs.String("out", "", "file to write the input to (default stdout)")
+	list := fs.Bool("params", false, "list the size and parameters of the day's generator")
+	set := make(aoc.Params)
+	fs.Func("set", "set a generator parameter, name=value (repeatable)", func(s string) error {
+		name, value, ok := strings.Cut(s, "=")
+		if !ok {
+			return fmt.Errorf("want name=value, got %q", s)
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("parameter %s: %w", name, err)
+		}
+		set[name] = n
+		return nil
+	})
+	fs.Parse(args)
+
+	if *day == 0 {
+		return fmt.Errorf("--day is required")
+	}
+	g, ok := aoc.LookupGenerator(*day)
+	if !ok {
+		return fmt.Errorf("no input generator for day %d", *day)
+	}
+
+	if *list {
+		fmt.Printf("size: %s (default %d)\n", g.Size, g.DefaultSize)
+		for _, p := range g.Params {
+			fmt.Printf("%s: %s (default %d)\n", p.Name, p.Usage, p.Default)
+		}
+		return nil
+	}
+
+	if *out == "" {
+		return g.Generate(os.Stdout, *seed, *size, set)
+	}
+	file, err := os.Create(*out)
+	if err != nil {
+		return err
+	}
+	if err := g.Generate(file, *seed, *size, set); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
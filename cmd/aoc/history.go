@@ -0,0 +1,54 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/janneh/advent-of-code-2025/aoc/cache"
+	"github.com/janneh/advent-of-code-2025/aoc/history"
+)
+
+func historyCmd(args []string) error {
+	fs := flag.NewFlagSet("history", flag.ExitOnError)
+	day := fs.Int("day", 0, "only show this day (0 for every day)")
+	part := fs.Int("part", 0, "only show this part (0 for both)")
+	hash := fs.String("hash", "", "only show runs on the input with this hash")
+	changes := fs.Bool("changes", false, "only show runs whose answer changed for the same input")
+	slower := fs.Float64("slower", 0, "only show runs at least this many times slower than the previous one, e.g. 1.5")
+	last := fs.Int("last", 20, "show at most this many of the latest runs (0 for all)")
+	file := fs.String("file", "", "run history (default "+cache.RepoDirName+"/"+history.File+" in the repository)")
+	fs.Parse(args)
+
+	if *file == "" {
+		var err error
+		if *file, err = history.DefaultFile(); err != nil {
+			return err
+		}
+	}
+	runs, err := history.Read(*file)
+	if err != nil {
+		return err
+	}
+
+	var shown []history.Trend
+	for _, t := range history.Trends(runs) {
+		switch {
+		case *day != 0 && t.Run.Day != *day,
+			*part != 0 && t.Run.Part != *part,
+			*hash != "" && t.Run.InputHash != *hash,
+			*changes && !t.AnswerChanged(),
+			*slower > 0 && t.Slowdown() < *slower:
+			continue
+		}
+		shown = append(shown, t)
+	}
+	if *last > 0 && len(shown) > *last {
+		shown = shown[len(shown)-*last:]
+	}
+	if len(shown) == 0 {
+		fmt.Fprintf(os.Stderr, "no matching runs in %s\n", *file)
+		return nil
+	}
+	return history.WriteTable(os.Stdout, shown)
+}
@@ -0,0 +1,82 @@
+// Command aoc runs any registered day and part of the calendar.
+//
+//	aoc run --day 8 --part 2 --input day08/input.txt
+//	aoc run --all --jobs 4
+//	aoc watch --day 8
+//	aoc repl --day 11
+//	aoc history --day 9 --changes
+//	aoc run --day 9 --part 2 --cpuprofile cpu.out --memprofile mem.out
+//	aoc bench --day 9 --json bench.json --compare old.json
+//	AOC_SESSION=... aoc fetch --day 12
+//	AOC_SESSION=... aoc submit --day 12 --part 1
+//	aoc new --day 13
+//	aoc gen --day 8 --size 5000 --seed 7 --set max=1000 > big.txt
+//
+// The days linked into the command are listed in days.go, which aoc new
+// regenerates.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: aoc <command> [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Commands:\n")
+	fmt.Fprintf(os.Stderr, "  run     solve a day's puzzle\n")
+	fmt.Fprintf(os.Stderr, "  watch   solve a day again whenever its inputs change\n")
+	fmt.Fprintf(os.Stderr, "  repl    explore a day's parsed input interactively\n")
+	fmt.Fprintf(os.Stderr, "  bench   benchmark parsing and solving\n")
+	fmt.Fprintf(os.Stderr, "  fetch   download a day's puzzle input\n")
+	fmt.Fprintf(os.Stderr, "  submit  solve a part and submit its answer\n")
+	fmt.Fprintf(os.Stderr, "  new     create the package of a new day\n")
+	fmt.Fprintf(os.Stderr, "  gen     generate a random puzzle input\n")
+	fmt.Fprintf(os.Stderr, "  history show past runs and changed answers\n")
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var err error
+	switch os.Args[1] {
+	case "run":
+		err = runCmd(ctx, os.Args[2:])
+	case "watch":
+		err = watchCmd(ctx, os.Args[2:])
+	case "repl":
+		err = replCmd(ctx, os.Args[2:])
+	case "bench":
+		err = benchCmd(os.Args[2:])
+	case "fetch":
+		err = fetchCmd(ctx, os.Args[2:])
+	case "submit":
+		err = submitCmd(ctx, os.Args[2:])
+	case "new":
+		err = newCmd(os.Args[2:])
+	case "gen":
+		err = genCmd(os.Args[2:])
+	case "history":
+		err = historyCmd(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		usage()
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command: %s\n\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var templates = template.Must(template.ParseFS(templateFS, "templates/*.tmpl"))
+
+// daysFile lists the day packages linked into the aoc command.
+const daysFile = "cmd/aoc/days.go"
+
+var dayDirRE = regexp.MustCompile(`^day\d\d$`)
+
+func newCmd(args []string) error {
+	fs := flag.NewFlagSet("new", flag.ExitOnError)
+	day := fs.Int("day", 0, "day to create (1-25)")
+	root := fs.String("root", ".", "repository root")
+	fs.Parse(args)
+
+	if *day < 1 || *day > 25 {
+		return fmt.Errorf("--day must be between 1 and 25")
+	}
+	if _, err := os.Stat(filepath.Join(*root, "go.mod")); err != nil {
+		return fmt.Errorf("%s is not the repository root: %w", *root, err)
+	}
+
+	pkg := fmt.Sprintf("day%02d", *day)
+	dir := filepath.Join(*root, pkg)
+	if _, err := os.Stat(dir); err == nil {
+		return fmt.Errorf("%s already exists", dir)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	data := struct {
+		Pkg string
+		Day int
+	}{pkg, *day}
+	files := []struct{ name, tmpl string }{
+		{pkg + ".go", "day.go.tmpl"},
+		{pkg + "_test.go", "day_test.go.tmpl"},
+		{"gen.go", "gen.go.tmpl"},
+		{filepath.Join("cmd", pkg, "main.go"), "main.go.tmpl"},
+		{"answers.txt", "answers.txt.tmpl"},
+		{"example.txt", ""},
+		{"input.txt", ""},
+	}
+	for _, f := range files {
+		var content []byte
+		if f.tmpl != "" {
+			var err error
+			content, err = execute(f.tmpl, data)
+			if err != nil {
+				return err
+			}
+		}
+		name := filepath.Join(dir, f.name)
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(name, content, 0o644); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "created %s\n", name)
+	}
+
+	if err := writeDaysFile(*root); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "registered %s with the aoc command\n", pkg)
+	return nil
+}
+
+// writeDaysFile regenerates daysFile from the day directories under root.
+func writeDaysFile(root string) error {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return err
+	}
+	var pkgs []string
+	for _, entry := range entries {
+		if entry.IsDir() && dayDirRE.MatchString(entry.Name()) {
+			pkgs = append(pkgs, entry.Name())
+		}
+	}
+
+	content, err := execute("days.go.tmpl", pkgs)
+	if err != nil {
+		return err
+	}
+	name := filepath.Join(root, daysFile)
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(name, content, 0o644)
+}
+
+// execute runs the named template, formatting the output of Go templates.
+func execute(name string, data any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, name, data); err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(name, ".go.tmpl") {
+		return buf.Bytes(), nil
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting %s: %w", name, err)
+	}
+	return src, nil
+}
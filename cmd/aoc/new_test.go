@@ -0,0 +1,57 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module github.com/janneh/advent-of-code-2025\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(root, "day01"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := newCmd([]string{"--day", "13", "--root", root}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{
+		"day13/day13.go",
+		"day13/day13_test.go",
+		"day13/gen.go",
+		"day13/cmd/day13/main.go",
+		"day13/answers.txt",
+		"day13/example.txt",
+		"day13/input.txt",
+	} {
+		if _, err := os.Stat(filepath.Join(root, name)); err != nil {
+			t.Errorf("%s not created: %v", name, err)
+		}
+	}
+
+	src, err := os.ReadFile(filepath.Join(root, "day13", "day13.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(src), "aoc.Register(13,") {
+		t.Errorf("day13.go does not register day 13:\n%s", src)
+	}
+
+	days, err := os.ReadFile(filepath.Join(root, daysFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pkg := range []string{"day01", "day13"} {
+		if !strings.Contains(string(days), "/"+pkg+"\"") {
+			t.Errorf("%s does not import %s:\n%s", daysFile, pkg, days)
+		}
+	}
+
+	if err := newCmd([]string{"--day", "13", "--root", root}); err == nil {
+		t.Errorf("creating an existing day succeeded")
+	}
+}
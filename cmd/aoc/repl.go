@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+func replCmd(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("repl", flag.ExitOnError)
+	var opts aoc.Options
+	day := fs.Int("day", 0, "day to explore (1-12)")
+	fs.BoolVar(&opts.Strict, "strict", false, "fail on the first problem in the input")
+	fs.DurationVar(&opts.Timeout, "timeout", 0, "time limit for part1 and part2, e.g. 30s (0 for none)")
+	input := fs.String("input", "", "input file (default dayNN/input.txt)")
+	explain := fs.String("explain", "", "trace every solver step to stderr as text or json")
+	fs.BoolVar(&opts.BigInt, "bigint", false, "allow answers too large for 64 bits")
+	fs.Parse(args)
+
+	if *day == 0 {
+		return fmt.Errorf("--day is required")
+	}
+	if *input == "" {
+		*input = aoc.DefaultInput(*day)
+	}
+	if *input == aoc.Stdin {
+		return fmt.Errorf("the REPL reads its commands from stdin, --input must be a file")
+	}
+	if *explain != "" {
+		var err error
+		if opts.Tracer, err = aoc.NewTracer(os.Stderr, *explain); err != nil {
+			return err
+		}
+	}
+	return aoc.REPL(ctx, os.Stdin, os.Stdout, *day, *input, opts)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+func TestREPL(t *testing.T) {
+	for _, tt := range []struct {
+		day    int
+		script string
+		want   []string
+	}{
+		{5, "fresh 17\nfresh 8\npart2\n", []string{
+			"17 fresh: true\nin range 3: 16-20\nin range 4: 12-18\nin merged range 10-20 of 11 IDs\n",
+			"8 fresh: false\n",
+			"14 (",
+		}},
+		{11, "paths you out\npaths bbb\nlookup\nquit\nunreachable\n", []string{
+			"5 paths from you to out\n",
+			"error: want 2 arguments, got 1\n",
+			"unknown command \"lookup\", type help for the list\n",
+		}},
+		{10, "machine 3\nmachine [#] (0) {2}\n", []string{
+			"part 1: fewest presses 2\npart 2: fewest presses 11\n",
+			"part 1: fewest presses 1\npart 2: fewest presses 2\n",
+		}},
+	} {
+		var out bytes.Buffer
+		input := filepath.Join("..", "..", fmt.Sprintf("day%02d", tt.day), "example.txt")
+		if err := aoc.REPL(context.Background(), strings.NewReader(tt.script), &out, tt.day, input, aoc.Options{}); err != nil {
+			t.Fatal(err)
+		}
+
+		// Replies follow each prompt; the last prompt gets none.
+		prompt := fmt.Sprintf("day%02d> ", tt.day)
+		replies := strings.Split(out.String(), prompt)[1:]
+		replies = replies[:len(replies)-1]
+		if len(replies) != len(tt.want) {
+			t.Fatalf("day %d: got %d replies, want %d:\n%s", tt.day, len(replies), len(tt.want), out.String())
+		}
+		for i, want := range tt.want {
+			if !strings.HasPrefix(replies[i], want) {
+				t.Errorf("day %d: reply %d = %q, want %q", tt.day, i, replies[i], want)
+			}
+		}
+	}
+}
+
+func TestREPLHelp(t *testing.T) {
+	for _, day := range aoc.Days() {
+		var out bytes.Buffer
+		input := filepath.Join("..", "..", fmt.Sprintf("day%02d", day), "example.txt")
+		if err := aoc.REPL(context.Background(), strings.NewReader("help\n"), &out, day, input, aoc.Options{}); err != nil {
+			t.Fatal(err)
+		}
+		for _, cmd := range append(aoc.LookupCommands(day), aoc.Command[any]{Name: "part1"}) {
+			if !strings.Contains(out.String(), "  "+cmd.Name+" ") {
+				t.Errorf("day %d help does not list %s:\n%s", day, cmd.Name, out.String())
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/history"
+)
+
+func runCmd(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	var opts aoc.Options
+	day := fs.Int("day", 0, "day to solve (1-12)")
+	fs.IntVar(&opts.Part, "part", 0, "part to solve (1 or 2, 0 for both)")
+	fs.BoolVar(&opts.Strict, "strict", false, "fail on the first problem in the input")
+	fs.StringVar(&opts.Format, "format", aoc.FormatText, "output format: text, json or csv")
+	fs.DurationVar(&opts.Timeout, "timeout", 0, "time limit per part, e.g. 30s (0 for none)")
+	input := fs.String("input", "", "input file, or - for stdin (default dayNN/input.txt)")
+	inputs := fs.String("inputs", "", "directory of input files to solve one by one")
+	all := fs.Bool("all", false, "solve every day in parallel")
+	jobs := fs.Int("jobs", runtime.NumCPU(), "number of parts solved at once with --all")
+	progress := fs.String("progress", aoc.ProgressAuto, "progress on stderr: auto, bar, json or none")
+	explain := fs.String("explain", "", "trace every solver step to stderr as text or json")
+	fs.BoolVar(&opts.BigInt, "bigint", false, "allow answers too large for 64 bits")
+	var profile aoc.Profile
+	profile.RegisterFlags(fs)
+	var hist history.Flags
+	hist.RegisterFlags(fs)
+	fs.Parse(args)
+
+	if opts.Part < 0 || opts.Part > 2 {
+		return fmt.Errorf("invalid part %d", opts.Part)
+	}
+	if *all && (*day != 0 || *input != "" || *inputs != "") {
+		return fmt.Errorf("--all cannot be combined with --day, --input or --inputs")
+	}
+	if !*all && *day == 0 {
+		return fmt.Errorf("--day or --all is required")
+	}
+	if *input != "" && *inputs != "" {
+		return fmt.Errorf("--input and --inputs cannot be combined")
+	}
+
+	var err error
+	if opts.Progress, err = aoc.NewProgress(os.Stderr, *progress); err != nil {
+		return err
+	}
+	if *explain != "" {
+		if opts.Tracer, err = aoc.NewTracer(os.Stderr, *explain); err != nil {
+			return err
+		}
+	}
+	if opts.History, err = hist.File(); err != nil {
+		return err
+	}
+	stopProfile, err := profile.Start()
+	if err != nil {
+		return err
+	}
+	return errors.Join(run(ctx, *day, *input, *inputs, *all, *jobs, opts), stopProfile())
+}
+
+func run(ctx context.Context, day int, input, inputs string, all bool, jobs int, opts aoc.Options) error {
+	if all {
+		return aoc.RunAll(ctx, os.Stdout, opts, jobs)
+	}
+	if inputs != "" {
+		return aoc.RunDir(ctx, os.Stdout, day, inputs, opts)
+	}
+	if input == "" {
+		input = aoc.DefaultInput(day)
+	}
+	return aoc.Run(ctx, os.Stdout, day, input, opts)
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/remote"
+)
+
+func submitCmd(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+	day := fs.Int("day", 0, "day to submit (1-12)")
+	part := fs.Int("part", 0, "part to submit (1 or 2)")
+	input := fs.String("input", "", "input file to solve (default dayNN/input.txt)")
+	answer := fs.String("answer", "", "submit this answer instead of solving")
+	logFile := fs.String("log", "", "answer log (default answers.jsonl in the cache directory)")
+	client := remoteFlags(fs)
+	fs.Parse(args)
+
+	if err := checkDay(*day); err != nil {
+		return err
+	}
+	if *part != 1 && *part != 2 {
+		return fmt.Errorf("--part must be 1 or 2")
+	}
+
+	if *answer == "" {
+		if *input == "" {
+			*input = aoc.DefaultInput(*day)
+		}
+		results, err := aoc.Solve(ctx, *day, *input, aoc.Options{Part: *part})
+		if err != nil {
+			return err
+		}
+		if len(results) != 1 {
+			return fmt.Errorf("day %d has no part %d", *day, *part)
+		}
+		if results[0].Err != "" {
+			return fmt.Errorf("solving day %d part %d: %s", *day, *part, results[0].Err)
+		}
+		*answer = results[0].Answer
+	}
+
+	c, err := client()
+	if err != nil {
+		return err
+	}
+	if *logFile == "" {
+		*logFile = filepath.Join(c.CacheDir, remote.LogFile)
+	}
+	attempts, err := remote.ReadLog(*logFile)
+	if err != nil {
+		return err
+	}
+	if err := remote.CheckAnswer(attempts, *day, *part, *answer); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "submitting %s for day %d part %d...\n", *answer, *day, *part)
+	verdict, msg, err := c.Submit(ctx, *day, *part, *answer)
+	if err != nil {
+		return err
+	}
+	attempt := remote.Attempt{
+		Day:     *day,
+		Part:    *part,
+		Answer:  *answer,
+		Verdict: verdict,
+		Message: msg,
+		Time:    time.Now().UTC(),
+	}
+	if err := remote.AppendLog(*logFile, attempt); err != nil {
+		return err
+	}
+
+	fmt.Println(msg)
+	if verdict != remote.Correct {
+		return fmt.Errorf("answer %s: %s", *answer, verdict)
+	}
+	return nil
+}
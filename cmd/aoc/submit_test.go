@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc/remote"
+	"github.com/janneh/advent-of-code-2025/aoc/remote/remotetest"
+)
+
+func TestSubmit(t *testing.T) {
+	srv := remotetest.NewServer(remote.Year, "secret", nil)
+	defer srv.Close()
+	srv.SetAnswer(1, 1, "3")
+
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, remote.LogFile)
+	submit := func(answer string) error {
+		return submitCmd(context.Background(), []string{
+			"--day", "1", "--part", "1", "--answer", answer,
+			"--url", srv.URL, "--session", "secret",
+			"--cache", dir, "--interval", "0", "--log", logFile,
+		})
+	}
+
+	if err := submit("5"); err == nil {
+		t.Errorf("submitting a wrong answer succeeded")
+	}
+	if err := submit("5"); !errors.Is(err, remote.ErrRejected) {
+		t.Errorf("resubmitting a wrong answer: err = %v, want %v", err, remote.ErrRejected)
+	}
+	if err := submit("8"); !errors.Is(err, remote.ErrRejected) {
+		t.Errorf("submitting above a too high answer: err = %v, want %v", err, remote.ErrRejected)
+	}
+	if err := submit("3"); err != nil {
+		t.Errorf("submitting the right answer: %v", err)
+	}
+
+	if got := srv.Requests(); got != 2 {
+		t.Errorf("server saw %d requests, want 2", got)
+	}
+	attempts, err := remote.ReadLog(logFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attempts) != 2 || attempts[0].Verdict != remote.TooHigh || attempts[1].Verdict != remote.Correct {
+		t.Errorf("answer log = %+v, want too high then correct", attempts)
+	}
+}
+
+func TestSubmitSolves(t *testing.T) {
+	srv := remotetest.NewServer(remote.Year, "secret", nil)
+	defer srv.Close()
+	srv.SetAnswer(1, 2, "6")
+
+	dir := t.TempDir()
+	err := submitCmd(context.Background(), []string{
+		"--day", "1", "--part", "2", "--input", "../../day01/example.txt",
+		"--url", srv.URL, "--session", "secret", "--cache", dir, "--interval", "0",
+	})
+	if err != nil {
+		t.Errorf("submitting the solved example answer: %v", err)
+	}
+}
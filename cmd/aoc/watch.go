@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/history"
+)
+
+func watchCmd(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+	var opts aoc.Options
+	day := fs.Int("day", 0, "day to solve (1-12)")
+	fs.IntVar(&opts.Part, "part", 0, "part to solve (1 or 2, 0 for both)")
+	fs.BoolVar(&opts.Strict, "strict", false, "fail on the first problem in the input")
+	fs.DurationVar(&opts.Timeout, "timeout", 0, "time limit per part, e.g. 30s (0 for none)")
+	input := fs.String("input", "", "input file to watch (default dayNN/input.txt and dayNN/example*.txt)")
+	interval := fs.Duration("interval", 500*time.Millisecond, "how often to check the files for changes")
+	fs.BoolVar(&opts.BigInt, "bigint", false, "allow answers too large for 64 bits")
+	var hist history.Flags
+	hist.RegisterFlags(fs)
+	fs.Parse(args)
+
+	if *day == 0 {
+		return fmt.Errorf("--day is required")
+	}
+	if opts.Part < 0 || opts.Part > 2 {
+		return fmt.Errorf("invalid part %d", opts.Part)
+	}
+	if *interval <= 0 {
+		return fmt.Errorf("invalid interval %v", *interval)
+	}
+	if _, ok := aoc.Lookup(*day); !ok {
+		return fmt.Errorf("no solver registered for day %d", *day)
+	}
+
+	var err error
+	if opts.History, err = hist.File(); err != nil {
+		return err
+	}
+
+	files := func() ([]string, error) { return dayFiles(*day) }
+	if *input != "" {
+		files = func() ([]string, error) { return []string{*input}, nil }
+	}
+	w := newWatcher(os.Stdout, *day, opts)
+	fmt.Fprintf(os.Stderr, "Watching day %d every %v, Ctrl-C to stop\n", *day, *interval)
+	return w.watch(ctx, files, *interval)
+}
+
+// dayFiles returns the input and examples of day that exist.
+func dayFiles(day int) ([]string, error) {
+	input := aoc.DefaultInput(day)
+	examples, err := filepath.Glob(filepath.Join(filepath.Dir(input), "example*.txt"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(examples)
+	return append(examples, input), nil
+}
+
+// fileState is what a watcher compares to notice a file has changed.
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// watcher re-solves the inputs of a day whenever they change and prints
+// every answer next to the previous one.
+type watcher struct {
+	w       io.Writer
+	day     int
+	opts    aoc.Options
+	seen    map[string]fileState
+	answers map[string]map[int]string
+}
+
+func newWatcher(w io.Writer, day int, opts aoc.Options) *watcher {
+	return &watcher{
+		w:       w,
+		day:     day,
+		opts:    opts,
+		seen:    make(map[string]fileState),
+		answers: make(map[string]map[int]string),
+	}
+}
+
+// watch polls the files every interval until ctx is done.
+func (wt *watcher) watch(ctx context.Context, files func() ([]string, error), interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		names, err := files()
+		if err != nil {
+			return err
+		}
+		if err := wt.poll(ctx, names); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll solves every file that is new or changed since the last poll, and
+// reports the ones that went away.
+func (wt *watcher) poll(ctx context.Context, names []string) error {
+	for _, name := range names {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		info, err := os.Stat(name)
+		if errors.Is(err, os.ErrNotExist) {
+			if _, ok := wt.seen[name]; ok {
+				delete(wt.seen, name)
+				fmt.Fprintf(wt.w, "== %s removed ==\n", name)
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		state := fileState{info.ModTime(), info.Size()}
+		old, ok := wt.seen[name]
+		if ok && old == state {
+			continue
+		}
+		wt.seen[name] = state
+
+		if ok {
+			fmt.Fprintf(wt.w, "== %s changed at %s ==\n", name, time.Now().Format(time.TimeOnly))
+		} else {
+			fmt.Fprintf(wt.w, "== %s ==\n", name)
+		}
+		if err := wt.solve(ctx, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// solve solves name and prints each part's answer, whether it changed, and
+// how long it took. An input that fails to parse keeps its old answers.
+func (wt *watcher) solve(ctx context.Context, name string) error {
+	results, err := aoc.Solve(ctx, wt.day, name, wt.opts)
+	if err != nil {
+		_, err = fmt.Fprintf(wt.w, "Error: %v\n", err)
+		return err
+	}
+
+	previous := wt.answers[name]
+	answers := make(map[int]string)
+	for _, res := range results {
+		var line string
+		switch {
+		case res.TimedOut:
+			line = fmt.Sprintf("Part %d: %s", res.Part, res.Err)
+		case res.Err != "":
+			line = fmt.Sprintf("Part %d: error: %s (%v)", res.Part, res.Err, res.Duration)
+		default:
+			answers[res.Part] = res.Answer
+			line = fmt.Sprintf("Part %d: %s", res.Part, res.Answer)
+			if old, ok := previous[res.Part]; ok && old == res.Answer {
+				line += ", unchanged"
+			} else if ok {
+				line += ", was " + old
+			}
+			line += fmt.Sprintf(" (%v)", res.Duration)
+		}
+		if _, err := fmt.Fprintln(wt.w, line); err != nil {
+			return err
+		}
+	}
+	wt.answers[name] = answers
+	return nil
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+func TestWatch(t *testing.T) {
+	ctx := context.Background()
+	name := filepath.Join(t.TempDir(), "example.txt")
+	write := func(data string, modTime time.Time) {
+		t.Helper()
+		if err := os.WriteFile(name, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(name, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Durations vary from run to run.
+	durations := regexp.MustCompile(` \([0-9.]+[µnm]?s\)`)
+	clock := regexp.MustCompile(`at [0-9:]+`)
+
+	var out bytes.Buffer
+	w := newWatcher(&out, 1, aoc.Options{})
+	modTime := time.Now().Add(-time.Hour)
+	// Each step writes the file, leaves it alone when empty, or removes
+	// it, then polls once.
+	for _, step := range []struct {
+		data string
+		want string
+	}{
+		{"R50\n", "== NAME ==\nPart 1: 1\nPart 2: 1\n"},
+		{"", ""},
+		{"R50\nL100\n", "== NAME changed at TIME ==\nPart 1: 2, was 1\nPart 2: 2, was 1\n"},
+		{"R50\nR100\n", "== NAME changed at TIME ==\nPart 1: 2, unchanged\nPart 2: 2, unchanged\n"},
+		{"remove", "== NAME removed ==\n"},
+	} {
+		switch step.data {
+		case "":
+		case "remove":
+			if err := os.Remove(name); err != nil {
+				t.Fatal(err)
+			}
+		default:
+			modTime = modTime.Add(time.Second)
+			write(step.data, modTime)
+		}
+
+		out.Reset()
+		if err := w.poll(ctx, []string{name}); err != nil {
+			t.Fatal(err)
+		}
+		got := durations.ReplaceAllString(out.String(), "")
+		got = clock.ReplaceAllString(got, "at TIME")
+		got = strings.ReplaceAll(got, name, "NAME")
+		if got != step.want {
+			t.Errorf("after writing %q got:\n%s\nwant:\n%s", step.data, got, step.want)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+// Command day01 solves day 1 against input.txt in the working directory.
+package main
+
+import (
+	"github.com/janneh/advent-of-code-2025/aoc"
+	_ "github.com/janneh/advent-of-code-2025/day01"
+)
+
+func main() {
+	aoc.Main(1)
+}
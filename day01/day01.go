@@ -0,0 +1,138 @@
+// Package day01 solves day 1 of Advent of Code 2025.
+package day01
+
+import (
+	"context"
+	"io"
+	"strconv"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+// Rotation turns the dial left or right by a number of clicks.
+type Rotation struct {
+	Direction byte
+	Distance  int
+}
+
+// String returns the rotation as written in the input, such as "L68".
+func (r Rotation) String() string {
+	return string(r.Direction) + strconv.Itoa(r.Distance)
+}
+
+// Parse reads one rotation per line, such as "L68" or "R14".
+func Parse(r io.Reader, diag *aoc.Diagnostics) ([]Rotation, error) {
+	var rotations []Rotation
+	scanner := aoc.NewLineScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		if len(line) < 2 {
+			if err := diag.Errorf(scanner.Line, 1, line, "rotation too short"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		direction := line[0]
+		if direction != 'L' && direction != 'R' {
+			if err := diag.Errorf(scanner.Line, 1, line, "invalid direction %q", direction); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		distance, err := strconv.Atoi(line[1:])
+		if err != nil || distance < 0 {
+			if err := diag.Errorf(scanner.Line, 2, line, "invalid distance"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		rotations = append(rotations, Rotation{direction, distance})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rotations, nil
+}
+
+// Part1 counts the rotations that leave the dial pointing at zero.
+func Part1(ctx context.Context, rotations []Rotation) int {
+	tr := aoc.Tracing(ctx)
+	position := 50
+	count := 0
+
+	for _, rot := range rotations {
+		switch rot.Direction {
+		case 'L':
+			position = ((position-rot.Distance)%100 + 100) % 100
+		case 'R':
+			position = (position + rot.Distance) % 100
+		}
+
+		if position == 0 {
+			count++
+		}
+		if tr != nil {
+			tr.Step("rotate", "rotation", rot, "dial", position, "zeros", count)
+		}
+	}
+
+	return count
+}
+
+func countZeros(start, distance int, goingRight bool) int {
+	if goingRight {
+		// Going right, we hit 0 every time we complete a cycle of 100
+		return (start + distance) / 100
+	}
+	// Going left
+	if start == 0 {
+		// Starting at 0, we hit it again at 100, 200, etc.
+		return distance / 100
+	}
+	// Starting at non-zero, we hit 0 at distances: start, start+100, start+200, ...
+	if distance >= start {
+		return 1 + (distance-start)/100
+	}
+	return 0
+}
+
+// Part2 counts every click that passes the dial over zero.
+func Part2(ctx context.Context, rotations []Rotation) int {
+	tr := aoc.Tracing(ctx)
+	position := 50
+	count := 0
+
+	for _, rot := range rotations {
+		var crossed int
+		switch rot.Direction {
+		case 'L':
+			crossed = countZeros(position, rot.Distance, false)
+			position = ((position-rot.Distance)%100 + 100) % 100
+		case 'R':
+			crossed = countZeros(position, rot.Distance, true)
+			position = (position + rot.Distance) % 100
+		}
+		count += crossed
+		if tr != nil {
+			tr.Step("rotate", "rotation", rot, "dial", position, "crossed", crossed, "zeros", count)
+		}
+	}
+
+	return count
+}
+
+func init() {
+	aoc.Register(1, aoc.Puzzle[[]Rotation]{
+		ParseFunc: Parse,
+		Part1Func: func(ctx context.Context, rotations []Rotation) (any, error) { return Part1(ctx, rotations), nil },
+		Part2Func: func(ctx context.Context, rotations []Rotation) (any, error) { return Part2(ctx, rotations), nil },
+	})
+}
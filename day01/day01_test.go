@@ -0,0 +1,65 @@
+package day01
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+func TestAnswers(t *testing.T) {
+	aoctest.Check(t, 1)
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.CheckGenerator(t, 1)
+}
+
+func TestExplain(t *testing.T) {
+	for _, tt := range []struct {
+		format string
+		want   []string
+	}{
+		{aoc.FormatText, []string{
+			"Day 1 Part 1: rotate rotation=L68 dial=82 zeros=0",
+			"Day 1 Part 1: rotate rotation=L30 dial=52 zeros=0",
+			"Day 1 Part 1: rotate rotation=R48 dial=0 zeros=1",
+		}},
+		{aoc.FormatJSON, []string{
+			`{"day":1,"part":1,"step":"rotate","rotation":"L68","dial":82,"zeros":0}`,
+			`{"day":1,"part":1,"step":"rotate","rotation":"L30","dial":52,"zeros":0}`,
+			`{"day":1,"part":1,"step":"rotate","rotation":"R48","dial":0,"zeros":1}`,
+		}},
+	} {
+		var buf bytes.Buffer
+		tracer, err := aoc.NewTracer(&buf, tt.format)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := aoc.Solve(context.Background(), 1, "example.txt", aoc.Options{Part: 1, Tracer: tracer}); err != nil {
+			t.Fatal(err)
+		}
+
+		// The example has ten rotations, one step each.
+		lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+		if len(lines) != 10 {
+			t.Fatalf("%s: got %d steps, want 10:\n%s", tt.format, len(lines), buf.String())
+		}
+		for i, want := range tt.want {
+			if lines[i] != want {
+				t.Errorf("%s: step %d = %s, want %s", tt.format, i, lines[i], want)
+			}
+		}
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	aoctest.Fuzz(f, 1)
+}
+
+func BenchmarkInput(b *testing.B) {
+	aoctest.Benchmark(b, 1)
+}
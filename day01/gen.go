@@ -0,0 +1,31 @@
+package day01
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+func generate(w io.Writer, rng *rand.Rand, size int, p aoc.Params) error {
+	if err := p.Positive("distance"); err != nil {
+		return err
+	}
+	for range size {
+		dir := "LR"[rng.IntN(2)]
+		fmt.Fprintf(w, "%c%d\n", dir, 1+rng.IntN(p["distance"]))
+	}
+	return nil
+}
+
+func init() {
+	aoc.RegisterGenerator(1, aoc.Generator{
+		Size:        "rotations",
+		DefaultSize: 4000,
+		Params: []aoc.Param{
+			{Name: "distance", Default: 1000, Usage: "largest rotation distance"},
+		},
+		Func: generate,
+	})
+}
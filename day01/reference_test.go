@@ -0,0 +1,45 @@
+package day01
+
+import (
+	"context"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+// turnDial turns the dial one click at a time, counting the rotations that
+// end at zero and the clicks that land on it.
+func turnDial(rotations []Rotation) (stops, clicks int) {
+	dial := 50
+	for _, rot := range rotations {
+		step := 1
+		if rot.Direction == 'L' {
+			step = 99
+		}
+		for range rot.Distance {
+			dial = (dial + step) % 100
+			if dial == 0 {
+				clicks++
+			}
+		}
+		if dial == 0 {
+			stops++
+		}
+	}
+	return stops, clicks
+}
+
+func TestReference(t *testing.T) {
+	aoctest.Differential(t, 1, aoc.Puzzle[[]Rotation]{
+		ParseFunc: Parse,
+		Part1Func: func(_ context.Context, rotations []Rotation) (any, error) {
+			stops, _ := turnDial(rotations)
+			return stops, nil
+		},
+		Part2Func: func(_ context.Context, rotations []Rotation) (any, error) {
+			_, clicks := turnDial(rotations)
+			return clicks, nil
+		},
+	}, aoctest.Inputs{Size: 30, Params: aoc.Params{"distance": 350}})
+}
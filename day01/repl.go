@@ -0,0 +1,39 @@
+package day01
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterCommands(1, aoc.Command[[]Rotation]{
+		Name:  "dial",
+		Args:  "<n>",
+		Usage: "show the dial and both zero counts after the first n rotations",
+		Func: func(ctx context.Context, w io.Writer, rotations []Rotation, args []string) error {
+			if err := aoc.ArgCount(args, 1); err != nil {
+				return err
+			}
+			i, err := aoc.Nth(args[0], len(rotations))
+			if err != nil {
+				return err
+			}
+			done := rotations[:i+1]
+			position := 50
+			for _, rot := range done {
+				if rot.Direction == 'L' {
+					position -= rot.Distance
+				} else {
+					position += rot.Distance
+				}
+			}
+			position = (position%100 + 100) % 100
+			fmt.Fprintf(w, "after %v the dial points at %d, stopped at zero %d times, passed it %d times\n",
+				rotations[i], position, Part1(ctx, done), Part2(ctx, done))
+			return nil
+		},
+	})
+}
@@ -0,0 +1,11 @@
+// Command day02 solves day 2 against input.txt in the working directory.
+package main
+
+import (
+	"github.com/janneh/advent-of-code-2025/aoc"
+	_ "github.com/janneh/advent-of-code-2025/day02"
+)
+
+func main() {
+	aoc.Main(2)
+}
@@ -0,0 +1,187 @@
+// Package day02 solves day 2 of Advent of Code 2025.
+package day02
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/checked"
+)
+
+// Range is an inclusive span of product IDs.
+type Range struct {
+	Start, End int
+}
+
+// String returns the range as written in the input, such as "11-22".
+func (r Range) String() string {
+	return strconv.Itoa(r.Start) + "-" + strconv.Itoa(r.End)
+}
+
+func isInvalidID(n int) bool {
+	s := strconv.Itoa(n)
+	// Must have even length to be splittable into two equal parts
+	if len(s)%2 != 0 {
+		return false
+	}
+
+	mid := len(s) / 2
+	left := s[:mid]
+	right := s[mid:]
+
+	// Check if both halves are equal
+	return left == right
+}
+
+// ParseRanges parses a comma separated list of "start-end" ranges.
+func ParseRanges(input string, diag *aoc.Diagnostics) ([]Range, error) {
+	var ranges []Range
+	offset := 0
+	// Split by comma, keeping track of where each part starts
+	for _, raw := range strings.Split(input, ",") {
+		partOffset := offset + len(raw) - len(strings.TrimLeftFunc(raw, unicode.IsSpace))
+		offset += len(raw) + 1
+
+		part := strings.TrimSpace(raw)
+		if part == "" {
+			continue
+		}
+
+		line, col := position(input, partOffset)
+
+		// Split by dash
+		rangeParts := strings.Split(part, "-")
+		if len(rangeParts) != 2 {
+			if err := diag.Errorf(line, col, part, "invalid range format"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		start, err := strconv.Atoi(strings.TrimSpace(rangeParts[0]))
+		if err != nil {
+			if err := diag.Errorf(line, col, part, "invalid start number"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		end, err := strconv.Atoi(strings.TrimSpace(rangeParts[1]))
+		if err != nil {
+			if err := diag.Errorf(line, col+len(rangeParts[0])+1, part, "invalid end number"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if start > end {
+			if err := diag.Errorf(line, col, part, "range start after end"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		ranges = append(ranges, Range{start, end})
+	}
+
+	return ranges, nil
+}
+
+// position converts a byte offset in input to a 1-based line and column.
+func position(input string, offset int) (int, int) {
+	line := 1 + strings.Count(input[:offset], "\n")
+	col := offset - strings.LastIndex(input[:offset], "\n")
+	return line, col
+}
+
+func isInvalidIDPart2(n int) bool {
+	s := strconv.Itoa(n)
+	length := len(s)
+
+	// Try all possible pattern lengths
+	for patternLen := 1; patternLen <= length/2; patternLen++ {
+		// Pattern length must divide the total length evenly
+		if length%patternLen != 0 {
+			continue
+		}
+
+		pattern := s[:patternLen]
+		repetitions := length / patternLen
+
+		// Check if repeating the pattern creates the entire string
+		if repetitions >= 2 {
+			repeated := strings.Repeat(pattern, repetitions)
+			if repeated == s {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ctxCheckInterval is how many IDs are checked between context checks.
+const ctxCheckInterval = 1 << 16
+
+// Part1 sums the IDs made of a digit sequence repeated twice.
+func Part1(ctx context.Context, ranges []Range) (checked.Int, error) {
+	tr := aoc.Tracing(ctx)
+	var sum checked.Int
+	for _, r := range ranges {
+		for id := r.Start; id <= r.End; id++ {
+			if id%ctxCheckInterval == 0 && ctx.Err() != nil {
+				return checked.Int{}, ctx.Err()
+			}
+			if isInvalidID(id) {
+				sum = sum.Add(checked.New(id))
+				if tr != nil {
+					tr.Step("invalid", "range", r, "id", id, "sum", sum)
+				}
+			}
+		}
+	}
+
+	return sum, nil
+}
+
+// Part2 sums the IDs made of a digit sequence repeated at least twice.
+func Part2(ctx context.Context, ranges []Range) (checked.Int, error) {
+	tr := aoc.Tracing(ctx)
+	var sum checked.Int
+	for _, r := range ranges {
+		for id := r.Start; id <= r.End; id++ {
+			if id%ctxCheckInterval == 0 && ctx.Err() != nil {
+				return checked.Int{}, ctx.Err()
+			}
+			if isInvalidIDPart2(id) {
+				sum = sum.Add(checked.New(id))
+				if tr != nil {
+					tr.Step("invalid", "range", r, "id", id, "sum", sum)
+				}
+			}
+		}
+	}
+
+	return sum, nil
+}
+
+// Parse reads the puzzle input and parses its ranges.
+func Parse(r io.Reader, diag *aoc.Diagnostics) ([]Range, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRanges(string(data), diag)
+}
+
+func init() {
+	aoc.Register(2, aoc.Puzzle[[]Range]{
+		ParseFunc: Parse,
+		Part1Func: func(ctx context.Context, ranges []Range) (any, error) { return Part1(ctx, ranges) },
+		Part2Func: func(ctx context.Context, ranges []Range) (any, error) { return Part2(ctx, ranges) },
+	})
+}
@@ -0,0 +1,70 @@
+package day02
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+// generate writes non-overlapping ranges of IDs of up to digits digits in
+// random order.
+func generate(w io.Writer, rng *rand.Rand, size int, p aoc.Params) error {
+	if err := p.Positive("digits", "width"); err != nil {
+		return err
+	}
+	if p["digits"] > 18 {
+		return fmt.Errorf("parameter digits must be at most 18, got %d", p["digits"])
+	}
+
+	ranges := make([]Range, size)
+	for i := range ranges {
+		digits := 1 + rng.IntN(p["digits"])
+		lo := pow10(digits - 1)
+		start := lo + rng.IntN(9*lo)
+		ranges[i] = Range{start, start + rng.IntN(p["width"])}
+	}
+
+	// Merge overlaps so every ID belongs to at most one range
+	slices.SortFunc(ranges, func(a, b Range) int { return a.Start - b.Start })
+	merged := ranges[:1]
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if r.Start <= last.End+1 {
+			last.End = max(last.End, r.End)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	rng.Shuffle(len(merged), func(i, j int) { merged[i], merged[j] = merged[j], merged[i] })
+
+	parts := make([]string, len(merged))
+	for i, r := range merged {
+		parts[i] = fmt.Sprintf("%d-%d", r.Start, r.End)
+	}
+	_, err := fmt.Fprintln(w, strings.Join(parts, ","))
+	return err
+}
+
+func pow10(n int) int {
+	p := 1
+	for range n {
+		p *= 10
+	}
+	return p
+}
+
+func init() {
+	aoc.RegisterGenerator(2, aoc.Generator{
+		Size:        "ranges",
+		DefaultSize: 40,
+		Params: []aoc.Param{
+			{Name: "digits", Default: 10, Usage: "most digits of an ID"},
+			{Name: "width", Default: 100000, Usage: "largest number of IDs in a range"},
+		},
+		Func: generate,
+	})
+}
@@ -0,0 +1,52 @@
+package day02
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+// repeatedTwice reports whether n is some h digit number d written twice,
+// that is d * (10^h + 1) with d having exactly h digits.
+func repeatedTwice(n int) bool {
+	for pow := 10; pow/10 <= n; pow *= 10 {
+		if n%(pow+1) == 0 {
+			if d := n / (pow + 1); d >= pow/10 && d < pow {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// periodic reports whether the digits of n are a shorter sequence repeated:
+// exactly then does n's string occur inside itself doubled, other than at
+// the start and the end.
+func periodic(n int) bool {
+	s := strconv.Itoa(n)
+	return strings.Contains((s + s)[1:2*len(s)-1], s)
+}
+
+func sumIDs(ranges []Range, invalid func(int) bool) int {
+	sum := 0
+	for _, r := range ranges {
+		for id := r.Start; id <= r.End; id++ {
+			if invalid(id) {
+				sum += id
+			}
+		}
+	}
+	return sum
+}
+
+func TestReference(t *testing.T) {
+	aoctest.Differential(t, 2, aoc.Puzzle[[]Range]{
+		ParseFunc: Parse,
+		Part1Func: func(_ context.Context, ranges []Range) (any, error) { return sumIDs(ranges, repeatedTwice), nil },
+		Part2Func: func(_ context.Context, ranges []Range) (any, error) { return sumIDs(ranges, periodic), nil },
+	}, aoctest.Inputs{Size: 10, Params: aoc.Params{"digits": 6, "width": 3000}})
+}
@@ -0,0 +1,34 @@
+package day02
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterCommands(2, aoc.Command[[]Range]{
+		Name:  "id",
+		Args:  "<id>",
+		Usage: "tell whether an ID is invalid in either part and which ranges hold it",
+		Func: func(_ context.Context, w io.Writer, ranges []Range, args []string) error {
+			if err := aoc.ArgCount(args, 1); err != nil {
+				return err
+			}
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid ID %q", args[0])
+			}
+			fmt.Fprintf(w, "part 1: invalid %v, part 2: invalid %v\n", isInvalidID(id), isInvalidIDPart2(id))
+			for i, r := range ranges {
+				if r.Start <= id && id <= r.End {
+					fmt.Fprintf(w, "in range %d: %v\n", i+1, r)
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,11 @@
+// Command day03 solves day 3 against input.txt in the working directory.
+package main
+
+import (
+	"github.com/janneh/advent-of-code-2025/aoc"
+	_ "github.com/janneh/advent-of-code-2025/day03"
+)
+
+func main() {
+	aoc.Main(3)
+}
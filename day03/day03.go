@@ -0,0 +1,144 @@
+// Package day03 solves day 3 of Advent of Code 2025.
+package day03
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/checked"
+)
+
+func maxJoltage(bank string) int {
+	maxJolt := 0
+
+	// Try all pairs of positions (i, j) where i < j
+	for i := 0; i < len(bank); i++ {
+		for j := i + 1; j < len(bank); j++ {
+			// Form the two-digit number from digits at positions i and j
+			d1 := int(bank[i] - '0')
+			d2 := int(bank[j] - '0')
+			joltage := d1*10 + d2
+
+			if joltage > maxJolt {
+				maxJolt = joltage
+			}
+		}
+	}
+
+	return maxJolt
+}
+
+// maxJoltagePart2 greedily picks the twelve batteries giving the largest
+// joltage, tracing every pick to tr.
+func maxJoltagePart2(bank string, tr *aoc.StepTracer) string {
+	n := len(bank)
+	k := 12
+
+	if n < k {
+		return bank
+	}
+
+	result := make([]byte, 0, k)
+	lastPos := -1
+
+	// Greedy selection: for each position, pick the largest digit
+	// while ensuring we have enough digits remaining
+	for i := 0; i < k; i++ {
+		remaining := k - i
+		maxDigit := byte('0')
+		maxPos := lastPos + 1
+
+		// Search for the maximum digit in the valid range
+		// We can search from (lastPos+1) to (n-remaining)
+		for j := lastPos + 1; j <= n-remaining; j++ {
+			if bank[j] > maxDigit {
+				maxDigit = bank[j]
+				maxPos = j
+			}
+		}
+
+		result = append(result, maxDigit)
+		if tr != nil {
+			tr.Step("pick", "window", bank[lastPos+1:n-remaining+1], "position", maxPos, "digit", string(maxDigit))
+		}
+		lastPos = maxPos
+	}
+
+	return string(result)
+}
+
+// Part1 sums the largest two-digit joltage of each bank.
+func Part1(ctx context.Context, banks []string) int {
+	tr := aoc.Tracing(ctx)
+	total := 0
+	for i, bank := range banks {
+		joltage := maxJoltage(bank)
+		total += joltage
+		if tr != nil {
+			tr.Step("bank", "bank", i+1, "joltage", joltage, "total", total)
+		}
+	}
+	return total
+}
+
+// Part2 sums the largest twelve-digit joltage of each bank.
+func Part2(ctx context.Context, banks []string) checked.Int {
+	tr := aoc.Tracing(ctx)
+	var total checked.Int
+	for i, bank := range banks {
+		joltageStr := maxJoltagePart2(bank, tr)
+		total = total.Add(digits(joltageStr))
+		if tr != nil {
+			tr.Step("bank", "bank", i+1, "joltage", joltageStr, "total", total)
+		}
+	}
+	return total
+}
+
+// digits returns the joltage of a string of battery digits, however many
+// there are.
+func digits(s string) checked.Int {
+	var n checked.Int
+	ten := checked.New(10)
+	for _, c := range s {
+		n = n.Mul(ten).Add(checked.New(int(c - '0')))
+	}
+	return n
+}
+
+// Parse reads one battery bank per line.
+func Parse(r io.Reader, diag *aoc.Diagnostics) ([]string, error) {
+	var banks []string
+	scanner := aoc.NewLineScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+
+		if i := strings.IndexFunc(line, func(c rune) bool { return c < '1' || c > '9' }); i != -1 {
+			if err := diag.Errorf(scanner.Line, i+1, line, "invalid joltage rating %q", line[i]); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		banks = append(banks, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return banks, nil
+}
+
+func init() {
+	aoc.Register(3, aoc.Puzzle[[]string]{
+		ParseFunc: Parse,
+		Part1Func: func(ctx context.Context, banks []string) (any, error) { return Part1(ctx, banks), nil },
+		Part2Func: func(ctx context.Context, banks []string) (any, error) { return Part2(ctx, banks), nil },
+	})
+}
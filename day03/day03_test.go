@@ -0,0 +1,23 @@
+package day03
+
+import (
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+func TestAnswers(t *testing.T) {
+	aoctest.Check(t, 3)
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.CheckGenerator(t, 3)
+}
+
+func FuzzParse(f *testing.F) {
+	aoctest.Fuzz(f, 3)
+}
+
+func BenchmarkInput(b *testing.B) {
+	aoctest.Benchmark(b, 3)
+}
@@ -0,0 +1,36 @@
+package day03
+
+import (
+	"io"
+	"math/rand/v2"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+func generate(w io.Writer, rng *rand.Rand, size int, p aoc.Params) error {
+	if err := p.Positive("length"); err != nil {
+		return err
+	}
+	bank := make([]byte, p["length"]+1)
+	bank[len(bank)-1] = '\n'
+	for range size {
+		for i := range p["length"] {
+			bank[i] = byte('1' + rng.IntN(9))
+		}
+		if _, err := w.Write(bank); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func init() {
+	aoc.RegisterGenerator(3, aoc.Generator{
+		Size:        "banks",
+		DefaultSize: 200,
+		Params: []aoc.Param{
+			{Name: "length", Default: 100, Usage: "batteries per bank"},
+		},
+		Func: generate,
+	})
+}
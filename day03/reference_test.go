@@ -0,0 +1,46 @@
+package day03
+
+import (
+	"context"
+	"math/bits"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+// bestJoltage tries every way to switch on k batteries of bank, or all of
+// them if it has fewer, and returns the largest joltage.
+func bestJoltage(bank string, k int) int {
+	k = min(k, len(bank))
+	best := 0
+	for mask := uint(0); mask < 1<<len(bank); mask++ {
+		if bits.OnesCount(mask) != k {
+			continue
+		}
+		joltage := 0
+		for i := range len(bank) {
+			if mask&(1<<i) != 0 {
+				joltage = joltage*10 + int(bank[i]-'0')
+			}
+		}
+		best = max(best, joltage)
+	}
+	return best
+}
+
+func sumJoltages(banks []string, k int) int {
+	total := 0
+	for _, bank := range banks {
+		total += bestJoltage(bank, k)
+	}
+	return total
+}
+
+func TestReference(t *testing.T) {
+	aoctest.Differential(t, 3, aoc.Puzzle[[]string]{
+		ParseFunc: Parse,
+		Part1Func: func(_ context.Context, banks []string) (any, error) { return sumJoltages(banks, 2), nil },
+		Part2Func: func(_ context.Context, banks []string) (any, error) { return sumJoltages(banks, 12), nil },
+	}, aoctest.Inputs{Size: 8, Params: aoc.Params{"length": 13}})
+}
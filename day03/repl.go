@@ -0,0 +1,50 @@
+package day03
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterCommands(3,
+		aoc.Command[[]string]{
+			Name:  "bank",
+			Args:  "<n>",
+			Usage: "show the largest joltages of the nth bank",
+			Func: func(_ context.Context, w io.Writer, banks []string, args []string) error {
+				if err := aoc.ArgCount(args, 1); err != nil {
+					return err
+				}
+				i, err := aoc.Nth(args[0], len(banks))
+				if err != nil {
+					return err
+				}
+				printJoltages(w, banks[i])
+				return nil
+			},
+		},
+		aoc.Command[[]string]{
+			Name:  "joltage",
+			Args:  "<batteries>",
+			Usage: "show the largest joltages of any bank, e.g. 987654321111111",
+			Func: func(_ context.Context, w io.Writer, _ []string, args []string) error {
+				if err := aoc.ArgCount(args, 1); err != nil {
+					return err
+				}
+				if strings.Trim(args[0], "0123456789") != "" {
+					return fmt.Errorf("bank %q holds more than digits", args[0])
+				}
+				printJoltages(w, args[0])
+				return nil
+			},
+		},
+	)
+}
+
+func printJoltages(w io.Writer, bank string) {
+	fmt.Fprintf(w, "%s: two batteries %d, twelve batteries %s\n", bank, maxJoltage(bank), maxJoltagePart2(bank, nil))
+}
@@ -0,0 +1,11 @@
+// Command day04 solves day 4 against input.txt in the working directory.
+package main
+
+import (
+	"github.com/janneh/advent-of-code-2025/aoc"
+	_ "github.com/janneh/advent-of-code-2025/day04"
+)
+
+func main() {
+	aoc.Main(4)
+}
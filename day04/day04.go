@@ -0,0 +1,110 @@
+// Package day04 solves day 4 of Advent of Code 2025.
+package day04
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/grid"
+)
+
+// accessible reports whether the paper roll at p has fewer than 4
+// neighbouring rolls.
+func accessible(g *grid.Grid[byte], p grid.Point) bool {
+	adjacent := 0
+	for n := range g.Neighbors8(p) {
+		if g.At(n) == '@' {
+			adjacent++
+		}
+	}
+	return adjacent < 4
+}
+
+// Part1 counts the paper rolls a forklift can reach.
+func Part1(ctx context.Context, g *grid.Grid[byte]) int {
+	tr := aoc.Tracing(ctx)
+	count := 0
+	for p, cell := range g.All() {
+		if cell == '@' && accessible(g, p) {
+			count++
+			if tr != nil {
+				tr.Step("reachable", "roll", p, "count", count)
+			}
+		}
+	}
+	return count
+}
+
+// Part2 counts the rolls removed by repeatedly taking every reachable one.
+func Part2(ctx context.Context, g *grid.Grid[byte]) int {
+	tr := aoc.Tracing(ctx)
+	g = g.Clone()
+	totalRemoved := 0
+
+	// Keep removing until no more accessible rolls
+	for round := 1; ; round++ {
+		var reachable []grid.Point
+		for p, cell := range g.All() {
+			if cell == '@' && accessible(g, p) {
+				reachable = append(reachable, p)
+			}
+		}
+
+		if len(reachable) == 0 {
+			break
+		}
+
+		for _, p := range reachable {
+			g.Set(p, '.')
+			totalRemoved++
+		}
+		if tr != nil {
+			tr.Step("round", "round", round, "removed", len(reachable), "total", totalRemoved)
+		}
+	}
+
+	return totalRemoved
+}
+
+// Parse reads the non-empty rows of the grid.
+func Parse(r io.Reader, diag *aoc.Diagnostics) (*grid.Grid[byte], error) {
+	var lines []string
+	scanner := aoc.NewLineScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+
+		if i := strings.IndexFunc(line, func(c rune) bool { return c != '.' && c != '@' }); i != -1 {
+			if err := diag.Errorf(scanner.Line, i+1, line, "invalid cell %q", line[i]); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if len(lines) > 0 && len(line) != len(lines[0]) {
+			if err := diag.Errorf(scanner.Line, 1, line, "row has %d cells, want %d", len(line), len(lines[0])); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return grid.FromLines(lines)
+}
+
+func init() {
+	aoc.Register(4, aoc.Puzzle[*grid.Grid[byte]]{
+		ParseFunc: Parse,
+		Part1Func: func(ctx context.Context, g *grid.Grid[byte]) (any, error) { return Part1(ctx, g), nil },
+		Part2Func: func(ctx context.Context, g *grid.Grid[byte]) (any, error) { return Part2(ctx, g), nil },
+	})
+}
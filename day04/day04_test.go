@@ -0,0 +1,23 @@
+package day04
+
+import (
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+func TestAnswers(t *testing.T) {
+	aoctest.Check(t, 4)
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.CheckGenerator(t, 4)
+}
+
+func FuzzParse(f *testing.F) {
+	aoctest.Fuzz(f, 4)
+}
+
+func BenchmarkInput(b *testing.B) {
+	aoctest.Benchmark(b, 4)
+}
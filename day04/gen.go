@@ -0,0 +1,42 @@
+package day04
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+// generate writes a square grid with about density percent of the cells
+// holding a roll of paper.
+func generate(w io.Writer, rng *rand.Rand, size int, p aoc.Params) error {
+	if p["density"] < 0 || p["density"] > 100 {
+		return fmt.Errorf("parameter density must be a percentage, got %d", p["density"])
+	}
+	row := make([]byte, size+1)
+	row[size] = '\n'
+	for range size {
+		for i := range size {
+			row[i] = '.'
+			if rng.IntN(100) < p["density"] {
+				row[i] = '@'
+			}
+		}
+		if _, err := w.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func init() {
+	aoc.RegisterGenerator(4, aoc.Generator{
+		Size:        "rows and columns",
+		DefaultSize: 140,
+		Params: []aoc.Param{
+			{Name: "density", Default: 60, Usage: "percentage of cells holding a roll"},
+		},
+		Func: generate,
+	})
+}
@@ -0,0 +1,78 @@
+package day04
+
+import (
+	"context"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+	"github.com/janneh/advent-of-code-2025/aoc/grid"
+)
+
+// floor copies the rows of g into plain byte slices.
+func floor(g *grid.Grid[byte]) [][]byte {
+	rows := make([][]byte, g.Rows)
+	for r := range rows {
+		rows[r] = append([]byte(nil), g.Row(r)...)
+	}
+	return rows
+}
+
+// reachable reports whether the roll at row r, column c has fewer than
+// four rolls among the eight cells around it.
+func reachable(rows [][]byte, r, c int) bool {
+	rolls := 0
+	for dr := -1; dr <= 1; dr++ {
+		for dc := -1; dc <= 1; dc++ {
+			nr, nc := r+dr, c+dc
+			if (dr != 0 || dc != 0) && nr >= 0 && nr < len(rows) && nc >= 0 && nc < len(rows[nr]) && rows[nr][nc] == '@' {
+				rolls++
+			}
+		}
+	}
+	return rolls < 4
+}
+
+func countReachable(g *grid.Grid[byte]) int {
+	rows := floor(g)
+	count := 0
+	for r := range rows {
+		for c := range rows[r] {
+			if rows[r][c] == '@' && reachable(rows, r, c) {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// removeOneByOne takes away a single reachable roll at a time until none
+// is left. Removing a roll never makes another unreachable, so the order
+// does not change the total.
+func removeOneByOne(g *grid.Grid[byte]) int {
+	rows := floor(g)
+	removed := 0
+	for {
+		found := false
+		for r := range rows {
+			for c := range rows[r] {
+				if !found && rows[r][c] == '@' && reachable(rows, r, c) {
+					rows[r][c] = '.'
+					removed++
+					found = true
+				}
+			}
+		}
+		if !found {
+			return removed
+		}
+	}
+}
+
+func TestReference(t *testing.T) {
+	aoctest.Differential(t, 4, aoc.Puzzle[*grid.Grid[byte]]{
+		ParseFunc: Parse,
+		Part1Func: func(_ context.Context, g *grid.Grid[byte]) (any, error) { return countReachable(g), nil },
+		Part2Func: func(_ context.Context, g *grid.Grid[byte]) (any, error) { return removeOneByOne(g), nil },
+	}, aoctest.Inputs{Size: 12, Params: aoc.Params{"density": 70}})
+}
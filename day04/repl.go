@@ -0,0 +1,71 @@
+package day04
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/grid"
+)
+
+func init() {
+	aoc.RegisterCommands(4, aoc.Command[*grid.Grid[byte]]{
+		Name:  "roll",
+		Args:  "<row> <col>",
+		Usage: "count the rolls next to a cell, from 0, and tell when part 2 removes it",
+		Func: func(_ context.Context, w io.Writer, g *grid.Grid[byte], args []string) error {
+			if err := aoc.ArgCount(args, 2); err != nil {
+				return err
+			}
+			row, err1 := strconv.Atoi(args[0])
+			col, err2 := strconv.Atoi(args[1])
+			p := grid.Point{Row: row, Col: col}
+			if err1 != nil || err2 != nil || !g.In(p) {
+				return fmt.Errorf("no cell at %s %s in a %dx%d grid", args[0], args[1], g.Rows, g.Cols)
+			}
+			if g.At(p) != '@' {
+				fmt.Fprintf(w, "%v is empty\n", p)
+				return nil
+			}
+
+			adjacent := 0
+			for n := range g.Neighbors8(p) {
+				if g.At(n) == '@' {
+					adjacent++
+				}
+			}
+			fmt.Fprintf(w, "%v has %d neighbouring rolls, reachable %v", p, adjacent, accessible(g, p))
+			if round := removalRound(g, p); round > 0 {
+				fmt.Fprintf(w, ", removed in round %d\n", round)
+			} else {
+				fmt.Fprintln(w, ", never removed")
+			}
+			return nil
+		},
+	})
+}
+
+// removalRound returns the round of Part2 that removes the roll at p, or 0
+// if it stays.
+func removalRound(g *grid.Grid[byte], p grid.Point) int {
+	g = g.Clone()
+	for round := 1; ; round++ {
+		var reachable []grid.Point
+		for q, cell := range g.All() {
+			if cell == '@' && accessible(g, q) {
+				reachable = append(reachable, q)
+			}
+		}
+		if len(reachable) == 0 {
+			return 0
+		}
+		for _, q := range reachable {
+			if q == p {
+				return round
+			}
+			g.Set(q, '.')
+		}
+	}
+}
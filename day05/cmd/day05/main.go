@@ -0,0 +1,11 @@
+// Command day05 solves day 5 against input.txt in the working directory.
+package main
+
+import (
+	"github.com/janneh/advent-of-code-2025/aoc"
+	_ "github.com/janneh/advent-of-code-2025/day05"
+)
+
+func main() {
+	aoc.Main(5)
+}
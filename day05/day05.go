@@ -0,0 +1,179 @@
+// Package day05 solves day 5 of Advent of Code 2025.
+package day05
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/checked"
+)
+
+// Range is an inclusive span of fresh ingredient IDs.
+type Range struct {
+	Start, End int
+}
+
+// String returns the range as written in the input, such as "3-5".
+func (r Range) String() string {
+	return strconv.Itoa(r.Start) + "-" + strconv.Itoa(r.End)
+}
+
+// Inventory holds the fresh ingredient ranges and the available IDs.
+type Inventory struct {
+	Ranges []Range
+	IDs    []int
+}
+
+// Parse reads the fresh ranges, a blank line, then the available IDs.
+func Parse(r io.Reader, diag *aoc.Diagnostics) (Inventory, error) {
+	var ranges []Range
+	var ids []int
+	parsingRanges := true
+
+	scanner := aoc.NewLineScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" {
+			parsingRanges = false
+			continue
+		}
+
+		if parsingRanges {
+			// Parse range like "3-5"
+			parts := strings.Split(line, "-")
+			if len(parts) != 2 {
+				if err := diag.Errorf(scanner.Line, 1, line, "invalid range format"); err != nil {
+					return Inventory{}, err
+				}
+				continue
+			}
+
+			start, err := strconv.Atoi(parts[0])
+			if err != nil {
+				if err := diag.Errorf(scanner.Line, 1, line, "invalid range start"); err != nil {
+					return Inventory{}, err
+				}
+				continue
+			}
+			end, err := strconv.Atoi(parts[1])
+			if err != nil {
+				if err := diag.Errorf(scanner.Line, len(parts[0])+2, line, "invalid range end"); err != nil {
+					return Inventory{}, err
+				}
+				continue
+			}
+			if start > end {
+				if err := diag.Errorf(scanner.Line, 1, line, "range start after end"); err != nil {
+					return Inventory{}, err
+				}
+				continue
+			}
+
+			ranges = append(ranges, Range{start, end})
+		} else {
+			// Parse single ID
+			id, err := strconv.Atoi(line)
+			if err != nil {
+				if err := diag.Errorf(scanner.Line, 1, line, "invalid ingredient ID"); err != nil {
+					return Inventory{}, err
+				}
+				continue
+			}
+			ids = append(ids, id)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Inventory{}, err
+	}
+
+	return Inventory{ranges, ids}, nil
+}
+
+// IsFresh reports whether id falls inside any of the ranges.
+func IsFresh(id int, ranges []Range) bool {
+	for _, r := range ranges {
+		if id >= r.Start && id <= r.End {
+			return true
+		}
+	}
+	return false
+}
+
+// Part1 counts the available IDs that are fresh.
+func Part1(ctx context.Context, ranges []Range, ids []int) int {
+	tr := aoc.Tracing(ctx)
+	count := 0
+	for _, id := range ids {
+		fresh := IsFresh(id, ranges)
+		if fresh {
+			count++
+		}
+		if tr != nil {
+			tr.Step("check", "id", id, "fresh", fresh, "count", count)
+		}
+	}
+	return count
+}
+
+// MergeRanges returns the ranges sorted by start with overlapping or
+// adjacent ranges combined. The input slice is left untouched.
+func MergeRanges(ranges []Range) []Range {
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	// Sort a copy of the ranges by start position
+	ranges = append([]Range(nil), ranges...)
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].Start < ranges[j].Start
+	})
+
+	// Merge overlapping or adjacent ranges
+	merged := []Range{ranges[0]}
+
+	for i := 1; i < len(ranges); i++ {
+		last := &merged[len(merged)-1]
+		current := ranges[i]
+
+		// If current overlaps or is adjacent to last, merge them
+		if current.Start <= last.End+1 {
+			if current.End > last.End {
+				last.End = current.End
+			}
+		} else {
+			merged = append(merged, current)
+		}
+	}
+
+	return merged
+}
+
+// Part2 counts every ID considered fresh by the ranges.
+func Part2(ctx context.Context, ranges []Range) checked.Int {
+	tr := aoc.Tracing(ctx)
+	// Count total IDs in merged ranges
+	var count checked.Int
+	for _, r := range MergeRanges(ranges) {
+		ids := checked.New(r.End - r.Start).Add(checked.New(1))
+		count = count.Add(ids)
+		if tr != nil {
+			tr.Step("merged", "range", r, "ids", ids, "count", count)
+		}
+	}
+
+	return count
+}
+
+func init() {
+	aoc.Register(5, aoc.Puzzle[Inventory]{
+		ParseFunc: Parse,
+		Part1Func: func(ctx context.Context, inv Inventory) (any, error) { return Part1(ctx, inv.Ranges, inv.IDs), nil },
+		Part2Func: func(ctx context.Context, inv Inventory) (any, error) { return Part2(ctx, inv.Ranges), nil },
+	})
+}
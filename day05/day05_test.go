@@ -0,0 +1,44 @@
+package day05
+
+import (
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+func TestAnswers(t *testing.T) {
+	aoctest.Check(t, 5)
+}
+
+// Bad numbers used to be skipped silently.
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name, input  string
+		line, column int
+	}{
+		{"range start", "3-5\nx-7\n\n1\n", 2, 1},
+		{"range end", "3-5\n10-y\n\n1\n", 2, 4},
+		{"range format", "3-5-7\n\n1\n", 1, 1},
+		{"ingredient", "3-5\n\n1\nfour\n", 4, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			perr := aoctest.StrictParseError(t, 5, tt.input)
+			if perr.Line != tt.line || perr.Column != tt.column {
+				t.Errorf("error at %d:%d, want %d:%d: %v", perr.Line, perr.Column, tt.line, tt.column, perr)
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.CheckGenerator(t, 5)
+}
+
+func FuzzParse(f *testing.F) {
+	aoctest.Fuzz(f, 5)
+}
+
+func BenchmarkInput(b *testing.B) {
+	aoctest.Benchmark(b, 5)
+}
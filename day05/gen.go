@@ -0,0 +1,37 @@
+package day05
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+func generate(w io.Writer, rng *rand.Rand, size int, p aoc.Params) error {
+	if err := p.Positive("ranges", "max", "width"); err != nil {
+		return err
+	}
+	for range p["ranges"] {
+		start := 1 + rng.IntN(p["max"])
+		fmt.Fprintf(w, "%d-%d\n", start, start+rng.IntN(p["width"]))
+	}
+	fmt.Fprintln(w)
+	for range size {
+		fmt.Fprintln(w, 1+rng.IntN(p["max"]))
+	}
+	return nil
+}
+
+func init() {
+	aoc.RegisterGenerator(5, aoc.Generator{
+		Size:        "ingredient IDs",
+		DefaultSize: 1000,
+		Params: []aoc.Param{
+			{Name: "ranges", Default: 180, Usage: "number of fresh ID ranges"},
+			{Name: "max", Default: 500_000_000_000_000, Usage: "largest ID"},
+			{Name: "width", Default: 10_000_000_000_000, Usage: "largest number of IDs in a range"},
+		},
+		Func: generate,
+	})
+}
@@ -0,0 +1,37 @@
+package day05
+
+import (
+	"context"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+// freshIDs lists every ID inside some range, one at a time.
+func freshIDs(ranges []Range) map[int]bool {
+	fresh := make(map[int]bool)
+	for _, r := range ranges {
+		for id := r.Start; id <= r.End; id++ {
+			fresh[id] = true
+		}
+	}
+	return fresh
+}
+
+func TestReference(t *testing.T) {
+	aoctest.Differential(t, 5, aoc.Puzzle[Inventory]{
+		ParseFunc: Parse,
+		Part1Func: func(_ context.Context, inv Inventory) (any, error) {
+			fresh := freshIDs(inv.Ranges)
+			count := 0
+			for _, id := range inv.IDs {
+				if fresh[id] {
+					count++
+				}
+			}
+			return count, nil
+		},
+		Part2Func: func(_ context.Context, inv Inventory) (any, error) { return len(freshIDs(inv.Ranges)), nil },
+	}, aoctest.Inputs{Size: 20, Params: aoc.Params{"ranges": 8, "max": 200, "width": 40}})
+}
@@ -0,0 +1,39 @@
+package day05
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterCommands(5, aoc.Command[Inventory]{
+		Name:  "fresh",
+		Args:  "<id>",
+		Usage: "tell whether an ingredient ID is fresh and which ranges hold it",
+		Func: func(_ context.Context, w io.Writer, inv Inventory, args []string) error {
+			if err := aoc.ArgCount(args, 1); err != nil {
+				return err
+			}
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid ID %q", args[0])
+			}
+			fmt.Fprintf(w, "%d fresh: %v\n", id, IsFresh(id, inv.Ranges))
+			for i, r := range inv.Ranges {
+				if r.Start <= id && id <= r.End {
+					fmt.Fprintf(w, "in range %d: %v\n", i+1, r)
+				}
+			}
+			for _, r := range MergeRanges(inv.Ranges) {
+				if r.Start <= id && id <= r.End {
+					fmt.Fprintf(w, "in merged range %v of %d IDs\n", r, r.End-r.Start+1)
+				}
+			}
+			return nil
+		},
+	})
+}
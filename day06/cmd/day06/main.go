@@ -0,0 +1,11 @@
+// Command day06 solves day 6 against input.txt in the working directory.
+package main
+
+import (
+	"github.com/janneh/advent-of-code-2025/aoc"
+	_ "github.com/janneh/advent-of-code-2025/day06"
+)
+
+func main() {
+	aoc.Main(6)
+}
@@ -0,0 +1,285 @@
+// Package day06 solves day 6 of Advent of Code 2025.
+package day06
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/checked"
+)
+
+func parseWorksheet(lines []string) [][]string {
+	paddedLines, spans := problemSpans(lines)
+
+	problems := [][]string{}
+	for _, span := range spans {
+		problem := make([]string, len(paddedLines))
+		for row := 0; row < len(paddedLines); row++ {
+			problem[row] = paddedLines[row][span[0]:span[1]]
+		}
+		problems = append(problems, problem)
+	}
+
+	return problems
+}
+
+// problemSpans pads the lines to the same width and returns them with the
+// [start, end) column span of every problem.
+func problemSpans(lines []string) ([]string, [][2]int) {
+	if len(lines) == 0 {
+		return nil, nil
+	}
+
+	// Find max width and pad all lines
+	maxWidth := 0
+	for _, line := range lines {
+		if len(line) > maxWidth {
+			maxWidth = len(line)
+		}
+	}
+
+	// Pad lines to same width
+	paddedLines := make([]string, len(lines))
+	for i, line := range lines {
+		paddedLines[i] = line + strings.Repeat(" ", maxWidth-len(line))
+	}
+
+	// Identify problem boundaries
+	// A column is a separator if it's all spaces
+	spans := [][2]int{}
+	inProblem := false
+	problemStart := 0
+
+	for col := 0; col <= maxWidth; col++ {
+		isSeparator := true
+		if col < maxWidth {
+			for row := 0; row < len(paddedLines); row++ {
+				if paddedLines[row][col] != ' ' {
+					isSeparator = false
+					break
+				}
+			}
+		}
+
+		if isSeparator || col == maxWidth {
+			if inProblem {
+				// End of problem
+				spans = append(spans, [2]int{problemStart, col})
+				inProblem = false
+			}
+		} else {
+			if !inProblem {
+				// Start of new problem
+				problemStart = col
+				inProblem = true
+			}
+		}
+	}
+
+	return paddedLines, spans
+}
+
+// evaluate applies operator to numbers, which give 0 when there are none.
+func evaluate(operator rune, numbers []checked.Int) checked.Int {
+	if len(numbers) == 0 {
+		return checked.Int{}
+	}
+
+	result := numbers[0]
+	for _, n := range numbers[1:] {
+		if operator == '*' {
+			result = result.Mul(n)
+		} else {
+			result = result.Add(n)
+		}
+	}
+
+	return result
+}
+
+func solveProblem(problem []string) checked.Int {
+	// Last line contains the operator
+	operatorLine := strings.TrimSpace(problem[len(problem)-1])
+	var operator rune
+	for _, ch := range operatorLine {
+		if ch == '*' || ch == '+' {
+			operator = ch
+			break
+		}
+	}
+
+	// Extract numbers from all lines except the last
+	numbers := []checked.Int{}
+	for i := 0; i < len(problem)-1; i++ {
+		numStr := strings.TrimSpace(problem[i])
+		if numStr != "" {
+			num, err := checked.Parse(numStr)
+			if err != nil {
+				continue
+			}
+			numbers = append(numbers, num)
+		}
+	}
+
+	return evaluate(operator, numbers)
+}
+
+func solveRightToLeft(problem []string) checked.Int {
+	// Read columns right-to-left
+	// Each column represents a number (read top-to-bottom)
+	if len(problem) == 0 || len(problem[0]) == 0 {
+		return checked.Int{}
+	}
+
+	width := len(problem[0])
+	var operator rune
+	numbers := []checked.Int{}
+
+	// Process columns from right to left
+	for col := width - 1; col >= 0; col-- {
+		// Extract digits from this column (top to bottom, excluding operator row)
+		digitStr := ""
+		for row := 0; row < len(problem)-1; row++ {
+			if col < len(problem[row]) && problem[row][col] != ' ' {
+				digitStr += string(problem[row][col])
+			}
+		}
+
+		// Check for operator in last row
+		lastRow := problem[len(problem)-1]
+		if col < len(lastRow) {
+			ch := lastRow[col]
+			if ch == '*' || ch == '+' {
+				operator = rune(ch)
+			}
+		}
+
+		// If we found a number, add it
+		if digitStr != "" {
+			num, err := checked.Parse(digitStr)
+			if err != nil {
+				continue
+			}
+			numbers = append(numbers, num)
+		}
+	}
+
+	return evaluate(operator, numbers)
+}
+
+// Part1 sums the answers of the worksheet read row by row.
+func Part1(ctx context.Context, lines []string) (checked.Int, error) {
+	tr := aoc.Tracing(ctx)
+	problems := parseWorksheet(lines)
+	var grandTotal checked.Int
+
+	for i, problem := range problems {
+		if err := ctx.Err(); err != nil {
+			return checked.Int{}, err
+		}
+		answer := solveProblem(problem)
+		grandTotal = grandTotal.Add(answer)
+		if tr != nil {
+			tr.Step("problem", "problem", i+1, "answer", answer, "total", grandTotal)
+		}
+	}
+
+	return grandTotal, nil
+}
+
+// Part2 sums the answers of the worksheet read column by column, right to left.
+func Part2(ctx context.Context, lines []string) (checked.Int, error) {
+	tr := aoc.Tracing(ctx)
+	problems := parseWorksheet(lines)
+	var grandTotal checked.Int
+
+	for i, problem := range problems {
+		if err := ctx.Err(); err != nil {
+			return checked.Int{}, err
+		}
+		answer := solveRightToLeft(problem)
+		grandTotal = grandTotal.Add(answer)
+		if tr != nil {
+			tr.Step("problem", "problem", i+1, "answer", answer, "total", grandTotal)
+		}
+	}
+
+	return grandTotal, nil
+}
+
+// Parse reads the worksheet lines as-is, keeping their alignment. Number
+// rows may only hold digits and the last row only operators. Invalid
+// characters are blanked out in lenient mode.
+func Parse(r io.Reader, diag *aoc.Diagnostics) ([]string, error) {
+	var lines []string
+	scanner := aoc.NewLineScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// Trailing blank lines would otherwise take the place of the operators
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) < 2 {
+		if err := diag.Errorf(len(lines), 0, "", "worksheet needs number rows and an operator row"); err != nil {
+			return nil, err
+		}
+		return lines, nil
+	}
+
+	for i, line := range lines {
+		valid := func(c byte) bool { return c == ' ' || c >= '0' && c <= '9' }
+		what := "digit"
+		if i == len(lines)-1 {
+			valid = func(c byte) bool { return c == ' ' || c == '*' || c == '+' }
+			what = "operator"
+		}
+
+		cleaned := []byte(line)
+		for col := range cleaned {
+			if valid(cleaned[col]) {
+				continue
+			}
+			if err := diag.Errorf(i+1, col+1, line, "invalid %s %q", what, cleaned[col]); err != nil {
+				return nil, err
+			}
+			cleaned[col] = ' '
+		}
+		lines[i] = string(cleaned)
+	}
+
+	paddedLines, spans := problemSpans(lines)
+	opRow := len(paddedLines) - 1
+	for _, span := range spans {
+		for row := 0; row < opRow; row++ {
+			cell := paddedLines[row][span[0]:span[1]]
+			if strings.Contains(strings.TrimSpace(cell), " ") {
+				if err := diag.Errorf(row+1, span[0]+1, cell, "problem cell holds more than one number"); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if ops := strings.TrimSpace(paddedLines[opRow][span[0]:span[1]]); len(ops) != 1 {
+			if err := diag.Errorf(opRow+1, span[0]+1, ops, "problem needs exactly one operator"); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return lines, nil
+}
+
+func init() {
+	aoc.Register(6, aoc.Puzzle[[]string]{
+		ParseFunc: Parse,
+		Part1Func: func(ctx context.Context, lines []string) (any, error) { return Part1(ctx, lines) },
+		Part2Func: func(ctx context.Context, lines []string) (any, error) { return Part2(ctx, lines) },
+	})
+}
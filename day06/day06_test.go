@@ -0,0 +1,77 @@
+package day06
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+func TestAnswers(t *testing.T) {
+	aoctest.Check(t, 6)
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.CheckGenerator(t, 6)
+}
+
+// Bad numbers used to be ignored when solving a problem.
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name, input  string
+		line, column int
+	}{
+		{"digit", "12 x4\n 3 56\n+  * \n", 1, 4},
+		{"operator", "12 34\n 3 56\n-  * \n", 3, 1},
+		{"no operator row", "12 34\n", 1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			perr := aoctest.StrictParseError(t, 6, tt.input)
+			if perr.Line != tt.line || perr.Column != tt.column {
+				t.Errorf("error at %d:%d, want %d:%d: %v", perr.Line, perr.Column, tt.line, tt.column, perr)
+			}
+		})
+	}
+}
+
+func TestBigInt(t *testing.T) {
+	// Both parts multiply numbers whose product needs 80 bits.
+	filename := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(filename, []byte("999999999999\n999999999999\n*           \n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	want := map[int]string{1: "999999999998000000000001", 2: "886384871716129280658801"}
+
+	results, err := aoc.Solve(context.Background(), 6, filename, aoc.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, res := range results {
+		if !strings.Contains(res.Err, "--bigint") {
+			t.Errorf("part %d: got answer %q, error %q, want an overflow error", res.Part, res.Answer, res.Err)
+		}
+	}
+
+	results, err = aoc.Solve(context.Background(), 6, filename, aoc.Options{BigInt: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, res := range results {
+		if res.Err != "" || res.Answer != want[res.Part] {
+			t.Errorf("part %d with BigInt: got answer %q, error %q, want %s", res.Part, res.Answer, res.Err, want[res.Part])
+		}
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	aoctest.Fuzz(f, 6)
+}
+
+func BenchmarkInput(b *testing.B) {
+	aoctest.Benchmark(b, 6)
+}
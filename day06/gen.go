@@ -0,0 +1,66 @@
+package day06
+
+import (
+	"io"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+// generate writes a worksheet of size problems side by side, each with a
+// column of numbers aligned left or right and its operator underneath.
+func generate(w io.Writer, rng *rand.Rand, size int, p aoc.Params) error {
+	if err := p.Positive("rows", "digits"); err != nil {
+		return err
+	}
+	rows := make([]strings.Builder, p["rows"]+1)
+	for problem := range size {
+		numbers := make([]string, p["rows"])
+		width := 0
+		for i := range numbers {
+			n := 0
+			for range 1 + rng.IntN(p["digits"]) {
+				n = n*10 + 1 + rng.IntN(9)
+			}
+			numbers[i] = strconv.Itoa(n)
+			width = max(width, len(numbers[i]))
+		}
+		alignLeft := rng.IntN(2) == 0
+
+		sep := " "
+		if problem == 0 {
+			sep = ""
+		}
+		for i, n := range numbers {
+			pad := strings.Repeat(" ", width-len(n))
+			if alignLeft {
+				rows[i].WriteString(sep + n + pad)
+			} else {
+				rows[i].WriteString(sep + pad + n)
+			}
+		}
+		op := "+*"[rng.IntN(2):][:1]
+		rows[len(numbers)].WriteString(sep + op + strings.Repeat(" ", width-1))
+	}
+
+	for i := range rows {
+		if _, err := io.WriteString(w, rows[i].String()+"\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func init() {
+	aoc.RegisterGenerator(6, aoc.Generator{
+		Size:        "problems",
+		DefaultSize: 1000,
+		Params: []aoc.Param{
+			{Name: "rows", Default: 4, Usage: "numbers per problem"},
+			{Name: "digits", Default: 4, Usage: "most digits of a number"},
+		},
+		Func: generate,
+	})
+}
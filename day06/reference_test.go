@@ -0,0 +1,107 @@
+package day06
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+func apply(op byte, numbers []int) int {
+	result := numbers[0]
+	for _, n := range numbers[1:] {
+		if op == '*' {
+			result *= n
+		} else {
+			result += n
+		}
+	}
+	return result
+}
+
+// byRows reads the worksheet as a table: the i-th field of every number
+// row is an operand of the problem whose operator is the i-th field of the
+// last row.
+func byRows(lines []string) (int, error) {
+	ops := strings.Fields(lines[len(lines)-1])
+	operands := make([][]int, len(ops))
+	for _, line := range lines[:len(lines)-1] {
+		fields := strings.Fields(line)
+		if len(fields) != len(ops) {
+			return 0, errors.New("ragged worksheet")
+		}
+		for i, field := range fields {
+			n, err := strconv.Atoi(field)
+			if err != nil {
+				return 0, err
+			}
+			operands[i] = append(operands[i], n)
+		}
+	}
+
+	total := 0
+	for i, op := range ops {
+		total += apply(op[0], operands[i])
+	}
+	return total, nil
+}
+
+// byColumns walks the columns of the worksheet from right to left, reading
+// each one top to bottom as a number until a blank column ends a problem.
+func byColumns(lines []string) (int, error) {
+	width := 0
+	for _, line := range lines {
+		width = max(width, len(line))
+	}
+	at := func(row, col int) byte {
+		if col < len(lines[row]) {
+			return lines[row][col]
+		}
+		return ' '
+	}
+
+	total := 0
+	var numbers []int
+	var op byte
+	for col := width - 1; col >= -1; col-- {
+		digits, blank := "", true
+		if col >= 0 {
+			for row := range len(lines) - 1 {
+				if c := at(row, col); c != ' ' {
+					digits += string(c)
+				}
+			}
+			if c := at(len(lines)-1, col); c != ' ' {
+				op, blank = c, false
+			}
+		}
+		if digits != "" {
+			n, err := strconv.Atoi(digits)
+			if err != nil {
+				return 0, err
+			}
+			numbers = append(numbers, n)
+			blank = false
+		}
+		if !blank {
+			continue
+		}
+		if len(numbers) > 0 {
+			total += apply(op, numbers)
+		}
+		numbers, op = nil, 0
+	}
+	return total, nil
+}
+
+func TestReference(t *testing.T) {
+	aoctest.Differential(t, 6, aoc.Puzzle[[]string]{
+		ParseFunc: Parse,
+		Part1Func: func(_ context.Context, lines []string) (any, error) { return byRows(lines) },
+		Part2Func: func(_ context.Context, lines []string) (any, error) { return byColumns(lines) },
+	}, aoctest.Inputs{Size: 6, Params: aoc.Params{"rows": 3, "digits": 3}})
+}
@@ -0,0 +1,32 @@
+package day06
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterCommands(6, aoc.Command[[]string]{
+		Name:  "problem",
+		Args:  "<n>",
+		Usage: "show the nth problem of the worksheet and its answers in both parts",
+		Func: func(_ context.Context, w io.Writer, lines []string, args []string) error {
+			if err := aoc.ArgCount(args, 1); err != nil {
+				return err
+			}
+			problems := parseWorksheet(lines)
+			i, err := aoc.Nth(args[0], len(problems))
+			if err != nil {
+				return err
+			}
+			for _, row := range problems[i] {
+				fmt.Fprintf(w, "|%s|\n", row)
+			}
+			fmt.Fprintf(w, "by rows: %v, by columns: %v\n", solveProblem(problems[i]), solveRightToLeft(problems[i]))
+			return nil
+		},
+	})
+}
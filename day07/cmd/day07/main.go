@@ -0,0 +1,11 @@
+// Command day07 solves day 7 against input.txt in the working directory.
+package main
+
+import (
+	"github.com/janneh/advent-of-code-2025/aoc"
+	_ "github.com/janneh/advent-of-code-2025/day07"
+)
+
+func main() {
+	aoc.Main(7)
+}
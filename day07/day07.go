@@ -0,0 +1,195 @@
+// Package day07 solves day 7 of Advent of Code 2025.
+package day07
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/checked"
+	"github.com/janneh/advent-of-code-2025/aoc/grid"
+)
+
+// Part1 counts the splitters hit by the tachyon beam.
+func Part1(ctx context.Context, g *grid.Grid[byte]) int {
+	tr := aoc.Tracing(ctx)
+	start, ok := grid.Find(g, 'S')
+	if !ok {
+		return 0
+	}
+
+	splitCount := 0
+	beams := []grid.Point{start}
+
+	// Simulate beams moving downward
+	for len(beams) > 0 {
+		newBeams := []grid.Point{}
+		splittersHit := make(map[grid.Point]bool)
+
+		for _, beam := range beams {
+			// Move beam down one step; it is gone once it exits the grid
+			next := beam.Add(grid.Down)
+			cell, ok := g.Get(next)
+			if !ok {
+				continue
+			}
+
+			// Check if beam hits a splitter
+			if cell == '^' {
+				splittersHit[next] = true
+				// Create two new beams at left and right of splitter
+				for _, side := range []grid.Point{next.Add(grid.Left), next.Add(grid.Right)} {
+					if g.In(side) {
+						newBeams = append(newBeams, side)
+					}
+				}
+			} else {
+				// Beam continues downward
+				newBeams = append(newBeams, next)
+			}
+		}
+
+		// Count unique splitters hit in this iteration
+		splitCount += len(splittersHit)
+		if tr != nil && len(splittersHit) > 0 {
+			tr.Step("row", "row", beams[0].Row+1, "beams", len(beams), "splits", len(splittersHit), "total", splitCount)
+		}
+
+		// Deduplicate beams at same position
+		beamSet := make(map[grid.Point]bool)
+		for _, beam := range newBeams {
+			beamSet[beam] = true
+		}
+		beams = []grid.Point{}
+		for beam := range beamSet {
+			beams = append(beams, beam)
+		}
+	}
+
+	return splitCount
+}
+
+// Part2 counts the timelines of a single quantum particle.
+func Part2(ctx context.Context, g *grid.Grid[byte]) (checked.Int, error) {
+	start, ok := grid.Find(g, 'S')
+	if !ok {
+		return checked.Int{}, nil
+	}
+	return timelines(ctx, g, start, aoc.Tracing(ctx))
+}
+
+// timelines counts the timelines of a particle starting at start, tracing
+// each splitter it reaches to tr. It stops with ctx's error once ctx is
+// done.
+func timelines(ctx context.Context, g *grid.Grid[byte], start grid.Point, tr *aoc.StepTracer) (checked.Int, error) {
+	// Memoization for counting paths from each position
+	memo := make(map[grid.Point]checked.Int)
+
+	var countPaths func(p grid.Point) (checked.Int, error)
+	countPaths = func(p grid.Point) (checked.Int, error) {
+		// Check bounds
+		if p.Col < 0 || p.Col >= g.Cols {
+			return checked.Int{}, nil
+		}
+
+		// Check if we've exited the grid
+		if p.Row >= g.Rows {
+			return checked.New(1), nil
+		}
+
+		// Check memo
+		if val, ok := memo[p]; ok {
+			return val, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return checked.Int{}, err
+		}
+
+		// Check next row
+		next := p.Add(grid.Down)
+		if next.Row >= g.Rows {
+			// About to exit
+			memo[p] = checked.New(1)
+			return checked.New(1), nil
+		}
+
+		// Check if next position is a splitter
+		var result checked.Int
+		if g.At(next) == '^' {
+			// Quantum split: particle takes both paths
+			left, err := countPaths(next.Add(grid.Left))
+			if err != nil {
+				return checked.Int{}, err
+			}
+			right, err := countPaths(next.Add(grid.Right))
+			if err != nil {
+				return checked.Int{}, err
+			}
+			result = left.Add(right)
+			if tr != nil {
+				tr.Step("split", "splitter", next, "timelines", result)
+			}
+		} else {
+			// Continue downward
+			var err error
+			if result, err = countPaths(next); err != nil {
+				return checked.Int{}, err
+			}
+		}
+
+		memo[p] = result
+		return result, nil
+	}
+
+	return countPaths(start)
+}
+
+// Parse reads the manifold diagram, which must contain a single start 'S'.
+func Parse(r io.Reader, diag *aoc.Diagnostics) (*grid.Grid[byte], error) {
+	var lines []string
+	starts := 0
+	scanner := aoc.NewLineScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+
+		if i := strings.IndexFunc(line, func(c rune) bool { return c != '.' && c != '^' && c != 'S' }); i != -1 {
+			if err := diag.Errorf(scanner.Line, i+1, line, "invalid cell %q", line[i]); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if len(lines) > 0 && len(line) != len(lines[0]) {
+			if err := diag.Errorf(scanner.Line, 1, line, "row has %d cells, want %d", len(line), len(lines[0])); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		starts += strings.Count(line, "S")
+		lines = append(lines, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if starts != 1 {
+		if err := diag.Errorf(0, 0, "", "diagram has %d starts, want 1", starts); err != nil {
+			return nil, err
+		}
+	}
+
+	return grid.FromLines(lines)
+}
+
+func init() {
+	aoc.Register(7, aoc.Puzzle[*grid.Grid[byte]]{
+		ParseFunc: Parse,
+		Part1Func: func(ctx context.Context, g *grid.Grid[byte]) (any, error) { return Part1(ctx, g), nil },
+		Part2Func: func(ctx context.Context, g *grid.Grid[byte]) (any, error) { return Part2(ctx, g) },
+	})
+}
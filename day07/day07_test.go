@@ -0,0 +1,37 @@
+package day07
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+func TestAnswers(t *testing.T) {
+	aoctest.Check(t, 7)
+}
+
+// Blank lines are skipped as in every other day, rather than failing the
+// row width check in strict mode.
+func TestParseBlankLines(t *testing.T) {
+	g, err := Parse(strings.NewReader("\n..S..\n.....\n..^..\n\n"), &aoc.Diagnostics{File: "input", Strict: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Rows != 3 || g.Cols != 5 {
+		t.Errorf("got a %dx%d grid, want 3x5", g.Rows, g.Cols)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.CheckGenerator(t, 7)
+}
+
+func FuzzParse(f *testing.F) {
+	aoctest.Fuzz(f, 7)
+}
+
+func BenchmarkInput(b *testing.B) {
+	aoctest.Benchmark(b, 7)
+}
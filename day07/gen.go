@@ -0,0 +1,50 @@
+package day07
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+// generate writes a manifold of size rows with the start in the middle of
+// the top row and splitters on every other row below it.
+func generate(w io.Writer, rng *rand.Rand, size int, p aoc.Params) error {
+	if err := p.Positive("width"); err != nil {
+		return err
+	}
+	if p["density"] < 0 || p["density"] > 100 {
+		return fmt.Errorf("parameter density must be a percentage, got %d", p["density"])
+	}
+	width := p["width"]
+	row := make([]byte, width+1)
+	row[width] = '\n'
+	for r := range size {
+		for c := range width {
+			row[c] = '.'
+			if r > 0 && r%2 == 0 && rng.IntN(100) < p["density"] {
+				row[c] = '^'
+			}
+		}
+		if r == 0 {
+			row[width/2] = 'S'
+		}
+		if _, err := w.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func init() {
+	aoc.RegisterGenerator(7, aoc.Generator{
+		Size:        "rows",
+		DefaultSize: 142,
+		Params: []aoc.Param{
+			{Name: "width", Default: 141, Usage: "columns of the manifold"},
+			{Name: "density", Default: 20, Usage: "percentage of splitter row cells holding a splitter"},
+		},
+		Func: generate,
+	})
+}
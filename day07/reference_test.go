@@ -0,0 +1,72 @@
+package day07
+
+import (
+	"context"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+	"github.com/janneh/advent-of-code-2025/aoc/grid"
+)
+
+// sweep moves the beams down the manifold one row at a time, tracking how
+// many timelines reach each column. It returns the number of splitters hit
+// and the number of timelines leaving the bottom; beams split off the side
+// of the manifold are lost.
+func sweep(g *grid.Grid[byte]) (splits, timelines int) {
+	timelinesAt := make([]int, g.Cols)
+	for r := range g.Rows {
+		if r > 0 {
+			var hit int
+			timelinesAt, hit = descend(g.Row(r), timelinesAt)
+			splits += hit
+		}
+		for c, cell := range g.Row(r) {
+			if cell == 'S' {
+				timelinesAt[c]++
+			}
+		}
+	}
+
+	for _, n := range timelinesAt {
+		timelines += n
+	}
+	return splits, timelines
+}
+
+// descend moves the timelines one row down onto row, splitting them at its
+// splitters, and returns them with the number of splitters hit.
+func descend(row []byte, timelinesAt []int) (next []int, hit int) {
+	next = make([]int, len(row))
+	for c, n := range timelinesAt {
+		if n == 0 {
+			continue
+		}
+		if row[c] != '^' {
+			next[c] += n
+			continue
+		}
+		hit++
+		if c > 0 {
+			next[c-1] += n
+		}
+		if c+1 < len(row) {
+			next[c+1] += n
+		}
+	}
+	return next, hit
+}
+
+func TestReference(t *testing.T) {
+	aoctest.Differential(t, 7, aoc.Puzzle[*grid.Grid[byte]]{
+		ParseFunc: Parse,
+		Part1Func: func(_ context.Context, g *grid.Grid[byte]) (any, error) {
+			splits, _ := sweep(g)
+			return splits, nil
+		},
+		Part2Func: func(_ context.Context, g *grid.Grid[byte]) (any, error) {
+			_, timelines := sweep(g)
+			return timelines, nil
+		},
+	}, aoctest.Inputs{Size: 14, Params: aoc.Params{"width": 15, "density": 40}})
+}
@@ -0,0 +1,36 @@
+package day07
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/grid"
+)
+
+func init() {
+	aoc.RegisterCommands(7, aoc.Command[*grid.Grid[byte]]{
+		Name:  "timelines",
+		Args:  "<row> <col>",
+		Usage: "count the timelines of a particle starting at a cell, from 0",
+		Func: func(ctx context.Context, w io.Writer, g *grid.Grid[byte], args []string) error {
+			if err := aoc.ArgCount(args, 2); err != nil {
+				return err
+			}
+			row, err1 := strconv.Atoi(args[0])
+			col, err2 := strconv.Atoi(args[1])
+			p := grid.Point{Row: row, Col: col}
+			if err1 != nil || err2 != nil || !g.In(p) {
+				return fmt.Errorf("no cell at %s %s in a %dx%d grid", args[0], args[1], g.Rows, g.Cols)
+			}
+			n, err := timelines(ctx, g, p, nil)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "%v timelines from %v\n", n, p)
+			return nil
+		},
+	})
+}
@@ -0,0 +1,11 @@
+// Command day08 solves day 8 against input.txt in the working directory.
+package main
+
+import (
+	"github.com/janneh/advent-of-code-2025/aoc"
+	_ "github.com/janneh/advent-of-code-2025/day08"
+)
+
+func main() {
+	aoc.Main(8)
+}
@@ -0,0 +1,191 @@
+// Package day08 solves day 8 of Advent of Code 2025.
+package day08
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/checked"
+	"github.com/janneh/advent-of-code-2025/aoc/geom"
+	"github.com/janneh/advent-of-code-2025/aoc/unionfind"
+)
+
+// Point is the position of a junction box.
+type Point = geom.Point3
+
+// Edge is a possible connection between two junction boxes and its squared
+// length.
+type Edge struct {
+	i, j  int
+	dist2 int
+}
+
+// ParsePoint parses an "x,y,z" junction box position. Errors are
+// *aoc.ParseError values carrying the column of the bad coordinate.
+func ParsePoint(line string) (Point, error) {
+	parts := strings.Split(line, ",")
+	if len(parts) != 3 {
+		return Point{}, &aoc.ParseError{Column: 1, Text: line, Err: errors.New("invalid point format")}
+	}
+
+	var coords [3]int
+	col := 1
+	for i, part := range parts {
+		n, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return Point{}, &aoc.ParseError{Column: col, Text: line, Err: fmt.Errorf("invalid coordinate %q", part)}
+		}
+		coords[i] = n
+		col += len(part) + 1
+	}
+
+	return Point{X: coords[0], Y: coords[1], Z: coords[2]}, nil
+}
+
+// Part1 connects the numConnections closest pairs and multiplies the sizes
+// of the three largest circuits.
+func Part1(ctx context.Context, points []Point, numConnections int) (checked.Int, error) {
+	n := len(points)
+
+	// Calculate all pairwise distances
+	var edges []Edge
+	for i := range n {
+		if err := ctx.Err(); err != nil {
+			return checked.Int{}, err
+		}
+		for j := i + 1; j < n; j++ {
+			edges = append(edges, Edge{i, j, points[i].Dist2(points[j])})
+		}
+	}
+
+	// Sort edges by distance (Kruskal's algorithm)
+	sort.Slice(edges, func(i, j int) bool {
+		return edges[i].dist2 < edges[j].dist2
+	})
+
+	// Use Union-Find to connect closest pairs
+	// Count attempts, not just successful connections
+	circuits := unionfind.New[int]()
+	for i := range n {
+		circuits.Add(i)
+	}
+
+	tr := aoc.Tracing(ctx)
+	for i := 0; i < numConnections && i < len(edges); i++ {
+		joined := circuits.Union(edges[i].i, edges[i].j)
+		if tr != nil {
+			tr.Step("connect", "a", points[edges[i].i], "b", points[edges[i].j], "dist2", edges[i].dist2, "joined", joined, "circuits", circuits.Count())
+		}
+	}
+
+	// Get component sizes
+	sizes := circuits.Sizes()
+
+	// Sort sizes in descending order
+	sort.Slice(sizes, func(i, j int) bool {
+		return sizes[i] > sizes[j]
+	})
+
+	// Multiply the three largest
+	if len(sizes) >= 3 {
+		return checked.New(sizes[0]).Mul(checked.New(sizes[1])).Mul(checked.New(sizes[2])), nil
+	}
+
+	return checked.Int{}, nil
+}
+
+// Part2 connects pairs until a single circuit remains and multiplies the X
+// coordinates of the last pair joined, or returns 0 without a pair.
+func Part2(ctx context.Context, points []Point) (checked.Int, error) {
+	n := len(points)
+
+	// Calculate all pairwise distances
+	var edges []Edge
+	for i := range n {
+		if err := ctx.Err(); err != nil {
+			return checked.Int{}, err
+		}
+		for j := i + 1; j < n; j++ {
+			edges = append(edges, Edge{i, j, points[i].Dist2(points[j])})
+		}
+	}
+
+	// Sort edges by distance
+	sort.Slice(edges, func(i, j int) bool {
+		return edges[i].dist2 < edges[j].dist2
+	})
+
+	// Use Union-Find to connect boxes until all are in one circuit
+	circuits := unionfind.New[int]()
+	for i := range n {
+		circuits.Add(i)
+	}
+	var lastEdge Edge
+	joined := false
+
+	tr := aoc.Tracing(ctx)
+	for _, edge := range edges {
+		if circuits.Union(edge.i, edge.j) {
+			lastEdge, joined = edge, true
+			if tr != nil {
+				tr.Step("connect", "a", points[edge.i], "b", points[edge.j], "dist2", edge.dist2, "circuits", circuits.Count())
+			}
+
+			// All boxes are in one circuit once a single component is left
+			if circuits.Count() == 1 {
+				break
+			}
+		}
+	}
+
+	// Fewer than two boxes leave no pair to connect
+	if !joined {
+		return checked.Int{}, nil
+	}
+
+	// Return product of X coordinates of last two boxes connected
+	return checked.New(points[lastEdge.i].X).Mul(checked.New(points[lastEdge.j].X)), nil
+}
+
+// Parse reads one junction box position per line.
+func Parse(r io.Reader, diag *aoc.Diagnostics) ([]Point, error) {
+	var points []Point
+	scanner := aoc.NewLineScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		point, err := ParsePoint(line)
+		if err != nil {
+			perr := err.(*aoc.ParseError)
+			perr.Line = scanner.Line
+			if err := diag.Report(perr); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		points = append(points, point)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return points, nil
+}
+
+func init() {
+	aoc.Register(8, aoc.Puzzle[[]Point]{
+		ParseFunc: Parse,
+		Part1Func: func(ctx context.Context, points []Point) (any, error) { return Part1(ctx, points, 1000) },
+		Part2Func: func(ctx context.Context, points []Point) (any, error) { return Part2(ctx, points) },
+	})
+}
@@ -0,0 +1,72 @@
+package day08
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+func TestAnswers(t *testing.T) {
+	aoctest.Check(t, 8)
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.CheckGenerator(t, 8)
+}
+
+func FuzzParse(f *testing.F) {
+	aoctest.Fuzz(f, 8)
+}
+
+func FuzzParsePoint(f *testing.F) {
+	aoctest.FuzzLine(f, "example.txt", ParsePoint)
+}
+
+func BenchmarkInput(b *testing.B) {
+	aoctest.Benchmark(b, 8)
+}
+
+// The example connects its 10 closest pairs rather than the 1000 used for
+// the puzzle input, so part 1 is checked here instead of in answers.txt.
+func TestPart1Example(t *testing.T) {
+	file, err := os.Open("example.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	points, err := Parse(file, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Part1(context.Background(), points, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "40"; got.String() != want {
+		t.Errorf("Part1(example, 10) = %v, want %s", got, want)
+	}
+}
+
+// Inputs with fewer than two boxes, such as an empty one or one whose every
+// line was skipped in lenient mode, have no pair to connect.
+func TestPart2NoPairs(t *testing.T) {
+	for _, input := range []string{"", "not,a,box\n", "162,817,812\n"} {
+		points, err := Parse(strings.NewReader(input), &aoc.Diagnostics{File: "input"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := Part2(context.Background(), points)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.String() != "0" {
+			t.Errorf("Part2(%q) = %v, want 0", input, got)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package day08
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+func generate(w io.Writer, rng *rand.Rand, size int, p aoc.Params) error {
+	if err := p.Positive("max"); err != nil {
+		return err
+	}
+	for range size {
+		fmt.Fprintf(w, "%d,%d,%d\n", rng.IntN(p["max"]), rng.IntN(p["max"]), rng.IntN(p["max"]))
+	}
+	return nil
+}
+
+func init() {
+	aoc.RegisterGenerator(8, aoc.Generator{
+		Size:        "junction boxes",
+		DefaultSize: 1000,
+		Params: []aoc.Param{
+			{Name: "max", Default: 100000, Usage: "coordinates are below max"},
+		},
+		Func: generate,
+	})
+}
@@ -0,0 +1,77 @@
+package day08
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+// circuits connects the closest pairs of boxes in turn, labelling every
+// box with its circuit and relabelling a whole circuit on each merge. It
+// stops after the first limit pairs, or once all boxes share a circuit
+// when limit is negative, and returns the labels and the last pair that
+// merged two circuits.
+func circuits(points []Point, limit int) (label []int, last [2]int) {
+	var pairs [][2]int
+	for i := range points {
+		for j := i + 1; j < len(points); j++ {
+			pairs = append(pairs, [2]int{i, j})
+		}
+	}
+	slices.SortStableFunc(pairs, func(a, b [2]int) int {
+		return points[a[0]].Dist2(points[a[1]]) - points[b[0]].Dist2(points[b[1]])
+	})
+
+	label = make([]int, len(points))
+	for i := range label {
+		label[i] = i
+	}
+	count := len(points)
+	for n, pair := range pairs {
+		if n == limit || count == 1 && limit < 0 {
+			break
+		}
+		from, to := label[pair[1]], label[pair[0]]
+		if from == to {
+			continue
+		}
+		for i := range label {
+			if label[i] == from {
+				label[i] = to
+			}
+		}
+		count--
+		last = pair
+	}
+	return label, last
+}
+
+func TestReference(t *testing.T) {
+	aoctest.Differential(t, 8, aoc.Puzzle[[]Point]{
+		ParseFunc: Parse,
+		Part1Func: func(_ context.Context, points []Point) (any, error) {
+			label, _ := circuits(points, 1000)
+			sizes := make([]int, len(points))
+			for _, l := range label {
+				sizes[l]++
+			}
+			slices.Sort(sizes)
+			slices.Reverse(sizes)
+			if len(sizes) < 3 || sizes[2] == 0 {
+				return 0, nil
+			}
+			return sizes[0] * sizes[1] * sizes[2], nil
+		},
+		Part2Func: func(_ context.Context, points []Point) (any, error) {
+			if len(points) < 2 {
+				return nil, errors.New("need two junction boxes to connect")
+			}
+			_, last := circuits(points, -1)
+			return points[last[0]].X * points[last[1]].X, nil
+		},
+	}, aoctest.Inputs{Size: 50})
+}
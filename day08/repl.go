@@ -0,0 +1,55 @@
+package day08
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterCommands(8,
+		aoc.Command[[]Point]{
+			Name:  "connect",
+			Args:  "<n>",
+			Usage: "solve part 1 connecting the n closest pairs instead of 1000",
+			Func: func(ctx context.Context, w io.Writer, points []Point, args []string) error {
+				if err := aoc.ArgCount(args, 1); err != nil {
+					return err
+				}
+				n, err := strconv.Atoi(args[0])
+				if err != nil || n < 0 {
+					return fmt.Errorf("invalid number of connections %q", args[0])
+				}
+				product, err := Part1(ctx, points, n)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(w, "three largest circuits after %d connections multiply to %v\n", n, product)
+				return nil
+			},
+		},
+		aoc.Command[[]Point]{
+			Name:  "dist",
+			Args:  "<i> <j>",
+			Usage: "show the squared distance between the ith and jth boxes",
+			Func: func(_ context.Context, w io.Writer, points []Point, args []string) error {
+				if err := aoc.ArgCount(args, 2); err != nil {
+					return err
+				}
+				i, err := aoc.Nth(args[0], len(points))
+				if err != nil {
+					return err
+				}
+				j, err := aoc.Nth(args[1], len(points))
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(w, "%v to %v: %d\n", points[i], points[j], points[i].Dist2(points[j]))
+				return nil
+			},
+		},
+	)
+}
@@ -0,0 +1,11 @@
+// Command day09 solves day 9 against input.txt in the working directory.
+package main
+
+import (
+	"github.com/janneh/advent-of-code-2025/aoc"
+	_ "github.com/janneh/advent-of-code-2025/day09"
+)
+
+func main() {
+	aoc.Main(9)
+}
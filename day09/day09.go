@@ -0,0 +1,253 @@
+// Package day09 solves day 9 of Advent of Code 2025.
+package day09
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/checked"
+	"github.com/janneh/advent-of-code-2025/aoc/geom"
+)
+
+// Point is the position of a red tile.
+type Point = geom.Point
+
+type RectCandidate struct {
+	i, j int
+	area checked.Int
+}
+
+// rectArea returns the number of tiles in the rectangle with corners a and b.
+func rectArea(a, b Point) checked.Int {
+	rect := geom.RectOf(a, b)
+	return checked.New(rect.Width()).Mul(checked.New(rect.Height()))
+}
+
+// Parse reads one red tile position per line.
+func Parse(r io.Reader, diag *aoc.Diagnostics) ([]Point, error) {
+	var tiles []Point
+	scanner := aoc.NewLineScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		parts := strings.Split(line, ",")
+		if len(parts) != 2 {
+			if err := diag.Errorf(scanner.Line, 1, line, "invalid tile format"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		x, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+		if err != nil {
+			if err := diag.Errorf(scanner.Line, 1, line, "invalid x coordinate"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		y, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil {
+			if err := diag.Errorf(scanner.Line, len(parts[0])+2, line, "invalid y coordinate"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		tiles = append(tiles, Point{X: x, Y: y})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return tiles, nil
+}
+
+// Part1 returns the largest rectangle with red tiles in opposite corners.
+func Part1(ctx context.Context, tiles []Point) checked.Int {
+	return largest(tiles, aoc.Tracing(ctx))
+}
+
+// largest returns the largest rectangle with red tiles in opposite corners,
+// explaining every improvement to tr.
+func largest(tiles []Point, tr *aoc.StepTracer) checked.Int {
+	var maxArea checked.Int
+
+	// Try all pairs of tiles as opposite corners
+	for i := range len(tiles) {
+		for j := i + 1; j < len(tiles); j++ {
+			if area := rectArea(tiles[i], tiles[j]); area.Cmp(maxArea) > 0 {
+				maxArea = area
+				if tr != nil {
+					tr.Step("best", "a", tiles[i], "b", tiles[j], "area", area)
+				}
+			}
+		}
+	}
+
+	return maxArea
+}
+
+// Part2 returns the largest such rectangle made only of red or green tiles.
+func Part2(ctx context.Context, tiles []Point) (checked.Int, error) {
+	polygon := geom.Polygon(tiles)
+	edges := polygon.Edges()
+
+	redTiles := make(map[Point]bool)
+	for _, tile := range tiles {
+		redTiles[tile] = true
+	}
+
+	var candidates []RectCandidate
+	areaLimit := largest(tiles, nil) // Use theoretical max
+
+	for i := range len(tiles) {
+		if err := ctx.Err(); err != nil {
+			return checked.Int{}, err
+		}
+		aoc.Report(ctx, aoc.Event{Phase: "generating candidates", Done: i, Total: len(tiles)})
+		for j := i + 1; j < len(tiles); j++ {
+			p1 := tiles[i]
+			p2 := tiles[j]
+
+			if p1.X == p2.X || p1.Y == p2.Y {
+				continue
+			}
+
+			area := rectArea(p1, p2)
+			if area.Cmp(areaLimit) > 0 {
+				continue
+			}
+
+			candidates = append(candidates, RectCandidate{i, j, area})
+		}
+	}
+
+	aoc.Report(ctx, aoc.Event{Phase: "sorting candidates", Done: len(candidates)})
+	sort.Slice(candidates, func(a, b int) bool {
+		return candidates[a].area.Cmp(candidates[b].area) > 0
+	})
+
+	insideCache := make(map[Point]bool)
+
+	isValidTileCached := func(p Point) bool {
+		if redTiles[p] {
+			return true
+		}
+		if result, ok := insideCache[p]; ok {
+			return result
+		}
+		valid := polygon.Contains(p)
+		insideCache[p] = valid
+		return valid
+	}
+
+	tr := aoc.Tracing(ctx)
+	var maxArea checked.Int
+	examined := 0
+
+	for _, cand := range candidates {
+		if cand.area.Cmp(maxArea) <= 0 {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return checked.Int{}, err
+		}
+
+		examined++
+		if examined%10000 == 0 {
+			aoc.Report(ctx, aoc.Event{Phase: "checking candidates", Done: examined, Total: len(candidates), Best: maxArea})
+		}
+
+		rect := geom.RectOf(tiles[cand.i], tiles[cand.j])
+		minX, minY, maxX, maxY := rect.Min.X, rect.Min.Y, rect.Max.X, rect.Max.Y
+
+		allCorners := true
+		for _, corner := range rect.Corners() {
+			if !isValidTileCached(corner) {
+				allCorners = false
+				break
+			}
+		}
+		if !allCorners {
+			if tr != nil {
+				tr.Step("reject", "a", tiles[cand.i], "b", tiles[cand.j], "area", cand.area, "reason", "corner outside")
+			}
+			continue
+		}
+
+		hasIntersection := false
+		for _, edge := range edges {
+			if edge.CrossesInterior(rect) {
+				hasIntersection = true
+				break
+			}
+		}
+
+		if !hasIntersection {
+			// With no edge through it the interior lies wholly inside or
+			// wholly outside the loop, as in a notch whose sides run along
+			// the rectangle's, so one interior tile decides.
+			if maxX-minX < 2 || maxY-minY < 2 || isValidTileCached(Point{X: minX + 1, Y: minY + 1}) {
+				maxArea = cand.area
+				if tr != nil {
+					tr.Step("best", "a", tiles[cand.i], "b", tiles[cand.j], "area", cand.area, "checked", examined)
+				}
+			} else if tr != nil {
+				tr.Step("reject", "a", tiles[cand.i], "b", tiles[cand.j], "area", cand.area, "reason", "interior outside")
+			}
+			continue
+		}
+
+		allValid := true
+		for x := minX; x <= maxX && allValid; x++ {
+			if !isValidTileCached(Point{X: x, Y: minY}) || (minY != maxY && !isValidTileCached(Point{X: x, Y: maxY})) {
+				allValid = false
+			}
+		}
+
+		if allValid {
+			for y := minY + 1; y < maxY && allValid; y++ {
+				if !isValidTileCached(Point{X: minX, Y: y}) || (minX != maxX && !isValidTileCached(Point{X: maxX, Y: y})) {
+					allValid = false
+				}
+			}
+		}
+
+		if allValid && maxX-minX > 1 && maxY-minY > 1 {
+			for x := minX + 1; x < maxX && allValid; x++ {
+				for y := minY + 1; y < maxY && allValid; y++ {
+					if !isValidTileCached(Point{X: x, Y: y}) {
+						allValid = false
+					}
+				}
+			}
+		}
+
+		if allValid {
+			maxArea = cand.area
+			if tr != nil {
+				tr.Step("best", "a", tiles[cand.i], "b", tiles[cand.j], "area", cand.area, "checked", examined)
+			}
+		} else if tr != nil {
+			tr.Step("reject", "a", tiles[cand.i], "b", tiles[cand.j], "area", cand.area, "reason", "tile outside")
+		}
+	}
+
+	return maxArea, nil
+}
+
+func init() {
+	aoc.Register(9, aoc.Puzzle[[]Point]{
+		ParseFunc: Parse,
+		Part1Func: func(ctx context.Context, tiles []Point) (any, error) { return Part1(ctx, tiles), nil },
+		Part2Func: func(ctx context.Context, tiles []Point) (any, error) { return Part2(ctx, tiles) },
+	})
+}
@@ -0,0 +1,64 @@
+package day09
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+// generate writes the corners of a random simple rectilinear polygon: a
+// run of columns between distinct x coordinates, each reaching up to its
+// own top above the middle and down to its own bottom below it. Walking
+// the tops left to right and the bottoms back gives about size corners.
+func generate(w io.Writer, rng *rand.Rand, size int, p aoc.Params) error {
+	columns := max(size/4, 1)
+	if p["max"] < 4*(columns+1) {
+		return fmt.Errorf("parameter max must be at least %d for %d corners, got %d", 4*(columns+1), size, p["max"])
+	}
+	maxCoord, mid := p["max"], p["max"]/2
+
+	seen := make(map[int]bool)
+	xs := make([]int, 0, columns+1)
+	for len(xs) < columns+1 {
+		if x := rng.IntN(maxCoord); !seen[x] {
+			seen[x] = true
+			xs = append(xs, x)
+		}
+	}
+	slices.Sort(xs)
+
+	// heights returns one value per column in [lo, hi), no two neighbours
+	// equal so every corner is a real turn.
+	heights := func(lo, hi int) []int {
+		hs := make([]int, columns)
+		for i := range hs {
+			for hs[i] = lo + rng.IntN(hi-lo); i > 0 && hs[i] == hs[i-1]; {
+				hs[i] = lo + rng.IntN(hi-lo)
+			}
+		}
+		return hs
+	}
+	tops, bottoms := heights(mid+1, maxCoord), heights(0, mid)
+
+	for i, top := range tops {
+		fmt.Fprintf(w, "%d,%d\n%d,%d\n", xs[i], top, xs[i+1], top)
+	}
+	for i := columns - 1; i >= 0; i-- {
+		fmt.Fprintf(w, "%d,%d\n%d,%d\n", xs[i+1], bottoms[i], xs[i], bottoms[i])
+	}
+	return nil
+}
+
+func init() {
+	aoc.RegisterGenerator(9, aoc.Generator{
+		Size:        "red tiles",
+		DefaultSize: 496,
+		Params: []aoc.Param{
+			{Name: "max", Default: 100000, Usage: "coordinates are below max"},
+		},
+		Func: generate,
+	})
+}
@@ -0,0 +1,138 @@
+package day09
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+	"github.com/janneh/advent-of-code-2025/aoc/geom"
+)
+
+// floorLimit bounds the coordinates the reference rasterizes.
+const floorLimit = 200
+
+// paintFloor draws the loop through the red tiles on a grid of tiles and
+// flood fills the outside from the border, returning which tiles are red
+// or green. Tiles are shifted by one so the border is always outside. It
+// rejects loops that are not simple and rectilinear.
+func paintFloor(tiles []Point) ([][]bool, error) {
+	if len(tiles) < 4 {
+		return nil, errors.New("too few red tiles for a loop")
+	}
+	size := 0
+	for _, t := range tiles {
+		if t.X < 0 || t.Y < 0 || t.X >= floorLimit || t.Y >= floorLimit {
+			return nil, errors.New("red tile outside the reference's floor")
+		}
+		size = max(size, t.X+3, t.Y+3)
+	}
+
+	loop := make([][]bool, size)
+	for x := range loop {
+		loop[x] = make([]bool, size)
+	}
+	for i, a := range tiles {
+		b := tiles[(i+1)%len(tiles)]
+		if a == b || a.X != b.X && a.Y != b.Y {
+			return nil, errors.New("loop is not rectilinear")
+		}
+		// Paint from just after a up to b, so a simple loop paints every
+		// tile exactly once.
+		step := geom.Point{X: sign(b.X - a.X), Y: sign(b.Y - a.Y)}
+		for p := a.Add(step); ; p = p.Add(step) {
+			if loop[p.X+1][p.Y+1] {
+				return nil, errors.New("loop is not simple")
+			}
+			loop[p.X+1][p.Y+1] = true
+			if p == b {
+				break
+			}
+		}
+	}
+
+	outside := make([][]bool, size)
+	for x := range outside {
+		outside[x] = make([]bool, size)
+	}
+	stack := []geom.Point{{X: 0, Y: 0}}
+	outside[0][0] = true
+	for len(stack) > 0 {
+		p := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, d := range []geom.Point{{X: 1}, {X: -1}, {Y: 1}, {Y: -1}} {
+			q := p.Add(d)
+			if q.X >= 0 && q.Y >= 0 && q.X < size && q.Y < size && !outside[q.X][q.Y] && !loop[q.X][q.Y] {
+				outside[q.X][q.Y] = true
+				stack = append(stack, q)
+			}
+		}
+	}
+
+	colored := make([][]bool, size)
+	for x := range colored {
+		colored[x] = make([]bool, size)
+		for y := range colored[x] {
+			colored[x][y] = !outside[x][y]
+		}
+	}
+	return colored, nil
+}
+
+func sign(n int) int {
+	switch {
+	case n > 0:
+		return 1
+	case n < 0:
+		return -1
+	}
+	return 0
+}
+
+// largestColored checks every tile of every rectangle between two red
+// tiles and returns the largest area made only of red or green tiles.
+func largestColored(tiles []Point) (int, error) {
+	colored, err := paintFloor(tiles)
+	if err != nil {
+		return 0, err
+	}
+
+	best := 0
+	for i, a := range tiles {
+		for _, b := range tiles[i+1:] {
+			ok := true
+			for x := min(a.X, b.X); ok && x <= max(a.X, b.X); x++ {
+				for y := min(a.Y, b.Y); ok && y <= max(a.Y, b.Y); y++ {
+					ok = colored[x+1][y+1]
+				}
+			}
+			if ok {
+				best = max(best, (max(a.X, b.X)-min(a.X, b.X)+1)*(max(a.Y, b.Y)-min(a.Y, b.Y)+1))
+			}
+		}
+	}
+	return best, nil
+}
+
+// largestAny returns the largest rectangle between two red tiles.
+func largestAny(tiles []Point) (int, error) {
+	if _, err := paintFloor(tiles); err != nil {
+		return 0, err
+	}
+	best := 0
+	for i, a := range tiles {
+		for _, b := range tiles[i+1:] {
+			best = max(best, (max(a.X, b.X)-min(a.X, b.X)+1)*(max(a.Y, b.Y)-min(a.Y, b.Y)+1))
+		}
+	}
+	return best, nil
+}
+
+func TestReference(t *testing.T) {
+	aoctest.Differential(t, 9, aoc.Puzzle[[]Point]{
+		ParseFunc: Parse,
+		Part1Func: func(_ context.Context, tiles []Point) (any, error) { return largestAny(tiles) },
+		Part2Func: func(_ context.Context, tiles []Point) (any, error) { return largestColored(tiles) },
+	}, aoctest.Inputs{Size: 24, Params: aoc.Params{"max": 60}})
+}
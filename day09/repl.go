@@ -0,0 +1,43 @@
+package day09
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/geom"
+)
+
+func init() {
+	aoc.RegisterCommands(9, aoc.Command[[]Point]{
+		Name:  "tile",
+		Args:  "<x,y>",
+		Usage: "tell whether a tile is red, green or neither",
+		Func: func(_ context.Context, w io.Writer, tiles []Point, args []string) error {
+			if err := aoc.ArgCount(args, 1); err != nil {
+				return err
+			}
+			xs, ys, _ := strings.Cut(args[0], ",")
+			x, err1 := strconv.Atoi(xs)
+			y, err2 := strconv.Atoi(ys)
+			if err1 != nil || err2 != nil {
+				return fmt.Errorf("invalid tile %q, want x,y", args[0])
+			}
+
+			p := Point{X: x, Y: y}
+			switch {
+			case slices.Contains(tiles, p):
+				fmt.Fprintf(w, "%v is red\n", p)
+			case geom.Polygon(tiles).Contains(p):
+				fmt.Fprintf(w, "%v is green\n", p)
+			default:
+				fmt.Fprintf(w, "%v is outside the loop\n", p)
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,11 @@
+// Command day10 solves day 10 against input.txt in the working directory.
+package main
+
+import (
+	"github.com/janneh/advent-of-code-2025/aoc"
+	_ "github.com/janneh/advent-of-code-2025/day10"
+)
+
+func main() {
+	aoc.Main(10)
+}
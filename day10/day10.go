@@ -0,0 +1,427 @@
+// Package day10 solves day 10 of Advent of Code 2025.
+package day10
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/checked"
+)
+
+// Machine describes the indicator lights, buttons and joltage targets of
+// one factory machine.
+type Machine struct {
+	Target   []int
+	Buttons  [][]int
+	Joltages []int
+}
+
+// ParseLine parses a single machine description such as
+// "[.##.] (3) (1,3) (2) {3,5,4,7}". Errors are *aoc.ParseError values
+// carrying the column of the problem.
+func ParseLine(line string) (Machine, error) {
+	fail := func(col int, format string, args ...any) (Machine, error) {
+		return Machine{}, &aoc.ParseError{Column: col + 1, Text: line, Err: fmt.Errorf(format, args...)}
+	}
+
+	start := strings.Index(line, "[")
+	if start == -1 {
+		return fail(0, "missing '[' before indicator lights")
+	}
+	end := strings.Index(line, "]")
+	if end < start {
+		return fail(start, "missing ']' after indicator lights")
+	}
+	pattern := line[start+1 : end]
+
+	target := make([]int, len(pattern))
+	for i, ch := range pattern {
+		switch ch {
+		case '#':
+			target[i] = 1
+		case '.':
+		default:
+			return fail(start+1+i, "invalid indicator light %q", ch)
+		}
+	}
+
+	rest := end + 1
+	var buttons [][]int
+
+	for {
+		start := strings.Index(line[rest:], "(")
+		if start == -1 {
+			break
+		}
+		start += rest
+		end := strings.Index(line[start:], ")")
+		if end == -1 {
+			return fail(start, "missing ')' after button wiring")
+		}
+		end += start
+
+		button, err := parseNumbers(line[start+1:end], start+1)
+		if err != nil {
+			return fail(err.col, "invalid button wiring: %v", err.err)
+		}
+		for _, light := range button {
+			if light < 0 || light >= len(target) {
+				return fail(start, "button toggles light %d of %d", light, len(target))
+			}
+		}
+		buttons = append(buttons, button)
+		rest = end + 1
+	}
+
+	joltStart := strings.Index(line, "{")
+	joltEnd := strings.Index(line, "}")
+	if joltStart == -1 || joltEnd < joltStart {
+		return fail(len(line), "missing {joltage requirements}")
+	}
+	joltages, err := parseNumbers(line[joltStart+1:joltEnd], joltStart+1)
+	if err != nil {
+		return fail(err.col, "invalid joltage requirement: %v", err.err)
+	}
+	if len(joltages) != len(target) {
+		return fail(joltStart, "%d joltage requirements for %d lights", len(joltages), len(target))
+	}
+
+	return Machine{Target: target, Buttons: buttons, Joltages: joltages}, nil
+}
+
+type numberError struct {
+	col int
+	err error
+}
+
+// parseNumbers parses a comma separated list of non-negative numbers that
+// starts at column offset of the line.
+func parseNumbers(list string, offset int) ([]int, *numberError) {
+	parts := strings.Split(list, ",")
+	nums := make([]int, 0, len(parts))
+	for _, p := range parts {
+		num, err := strconv.Atoi(strings.TrimSpace(p))
+		if err == nil && num < 0 {
+			err = fmt.Errorf("negative number %d", num)
+		}
+		if err != nil {
+			return nil, &numberError{offset, err}
+		}
+		nums = append(nums, num)
+		offset += len(p) + 1
+	}
+	return nums, nil
+}
+
+// ctxCheckInterval is how many button masks are tried between context
+// checks.
+const ctxCheckInterval = 1 << 12
+
+func solvePart1(ctx context.Context, machine Machine) (int, error) {
+	numLights := len(machine.Target)
+	numButtons := len(machine.Buttons)
+
+	if numButtons > 25 {
+		return -1, nil
+	}
+
+	minPresses := numButtons + 1
+
+	for mask := 0; mask < (1 << numButtons); mask++ {
+		if mask%ctxCheckInterval == 0 && ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+
+		lights := make([]int, numLights)
+
+		for buttonIdx := range numButtons {
+			if (mask & (1 << buttonIdx)) != 0 {
+				for _, lightIdx := range machine.Buttons[buttonIdx] {
+					if lightIdx < numLights {
+						lights[lightIdx] ^= 1
+					}
+				}
+			}
+		}
+
+		match := true
+		for i := 0; i < numLights; i++ {
+			if lights[i] != machine.Target[i] {
+				match = false
+				break
+			}
+		}
+
+		if match {
+			presses := 0
+			for buttonIdx := 0; buttonIdx < numButtons; buttonIdx++ {
+				if (mask & (1 << buttonIdx)) != 0 {
+					presses++
+				}
+			}
+			if presses < minPresses {
+				minPresses = presses
+			}
+		}
+	}
+
+	if minPresses == numButtons+1 {
+		return -1, nil
+	}
+	return minPresses, nil
+}
+
+func solvePart2(ctx context.Context, machine Machine) (int, error) {
+	numCounters := len(machine.Joltages)
+	numButtons := len(machine.Buttons)
+
+	if numCounters == 0 || numButtons == 0 {
+		return 0, nil
+	}
+
+	A := make([][]float64, numCounters)
+	for i := range A {
+		A[i] = make([]float64, numButtons)
+	}
+
+	for buttonIdx, button := range machine.Buttons {
+		for _, counterIdx := range button {
+			if counterIdx < numCounters {
+				A[counterIdx][buttonIdx] = 1
+			}
+		}
+	}
+
+	b := make([]float64, numCounters)
+	for i, val := range machine.Joltages {
+		b[i] = float64(val)
+	}
+
+	return recursiveDivideConquer(ctx, A, b, numButtons)
+}
+
+func recursiveDivideConquer(ctx context.Context, A [][]float64, b []float64, numButtons int) (int, error) {
+	target := make([]int, len(b))
+	for i, v := range b {
+		target[i] = int(v)
+	}
+	memo := make(map[string]int)
+	return solveRecursive(ctx, A, target, numButtons, memo)
+}
+
+func solveRecursive(ctx context.Context, A [][]float64, target []int, numButtons int, memo map[string]int) (int, error) {
+	allZero := true
+	for _, v := range target {
+		if v != 0 {
+			allZero = false
+			break
+		}
+	}
+	if allZero {
+		return 0, nil
+	}
+
+	key := arrayToString(target)
+	if val, ok := memo[key]; ok {
+		return val, nil
+	}
+
+	minPresses, found := 0, false
+	numCounters := len(target)
+
+	parityTarget := make([]int, numCounters)
+	for i, v := range target {
+		if v%2 == 1 {
+			parityTarget[i] = 1
+		}
+	}
+
+	if numButtons > 25 {
+		memo[key] = -1
+		return -1, nil
+	}
+
+	maxMask := 1 << numButtons
+	for mask := range maxMask {
+		if mask%ctxCheckInterval == 0 && ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+
+		parity := make([]int, numCounters)
+		effect := make([]int, numCounters)
+		pressCount := 0
+
+		for btn := range numButtons {
+			if (mask & (1 << btn)) != 0 {
+				pressCount++
+				for ctr := range numCounters {
+					if A[ctr][btn] > 0.5 {
+						parity[ctr] ^= 1 // XOR for parity
+						effect[ctr]++    // Actual effect
+					}
+				}
+			}
+		}
+
+		parityMatch := true
+		for i := range parityTarget {
+			if parity[i] != parityTarget[i] {
+				parityMatch = false
+				break
+			}
+		}
+
+		if !parityMatch {
+			continue
+		}
+
+		remaining := make([]int, numCounters)
+		allEven := true
+		anyNegative := false
+
+		for i := range target {
+			remaining[i] = target[i] - effect[i]
+			if remaining[i] < 0 {
+				anyNegative = true
+				break
+			}
+			if remaining[i]%2 != 0 {
+				allEven = false
+				break
+			}
+		}
+
+		if !allEven || anyNegative {
+			continue
+		}
+
+		halfRemaining := make([]int, numCounters)
+		for i := range remaining {
+			halfRemaining[i] = remaining[i] / 2
+		}
+
+		recursivePresses, err := solveRecursive(ctx, A, halfRemaining, numButtons, memo)
+		if err != nil {
+			return 0, err
+		}
+		if recursivePresses != -1 {
+			// Formula: k + 2 * f((b-effect)/2)
+			doubled, ok := checked.Mul(2, recursivePresses)
+			if !ok {
+				return 0, fmt.Errorf("presses for joltages %v: %w", target, checked.ErrOverflow)
+			}
+			totalPresses, ok := checked.Add(pressCount, doubled)
+			if !ok {
+				return 0, fmt.Errorf("presses for joltages %v: %w", target, checked.ErrOverflow)
+			}
+			if !found || totalPresses < minPresses {
+				minPresses, found = totalPresses, true
+			}
+		}
+	}
+
+	result := -1
+	if found {
+		result = minPresses
+	}
+
+	memo[key] = result
+	return result, nil
+}
+
+func arrayToString(arr []int) string {
+	var sb strings.Builder
+	for i, v := range arr {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		fmt.Fprintf(&sb, "%d", v)
+	}
+	return sb.String()
+}
+
+// Parse reads one machine per line.
+func Parse(r io.Reader, diag *aoc.Diagnostics) ([]Machine, error) {
+	var machines []Machine
+	scanner := aoc.NewLineScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		machine, err := ParseLine(line)
+		if err != nil {
+			perr := err.(*aoc.ParseError)
+			perr.Line = scanner.Line
+			if err := diag.Report(perr); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		machines = append(machines, machine)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return machines, nil
+}
+
+// Part1 sums the fewest presses needed to configure every indicator light.
+func Part1(ctx context.Context, machines []Machine) (checked.Int, error) {
+	return sumPresses(ctx, machines, solvePart1)
+}
+
+// Part2 sums the fewest presses needed to reach every joltage target.
+func Part2(ctx context.Context, machines []Machine) (checked.Int, error) {
+	return sumPresses(ctx, machines, solvePart2)
+}
+
+// sumPresses adds up the presses solve finds for every machine, skipping
+// the ones it finds no solution for and warning about them. Progress is
+// reported per machine, counting the skipped ones in the phase, and
+// explained with its presses.
+func sumPresses(ctx context.Context, machines []Machine, solve func(context.Context, Machine) (int, error)) (checked.Int, error) {
+	tr := aoc.Tracing(ctx)
+	var total checked.Int
+	var skipped []string
+	for i, machine := range machines {
+		phase := "configuring machines"
+		if len(skipped) > 0 {
+			phase = fmt.Sprintf("configuring machines, %d skipped", len(skipped))
+		}
+		aoc.Report(ctx, aoc.Event{Phase: phase, Done: i, Total: len(machines)})
+
+		presses, err := solve(ctx, machine)
+		if err != nil {
+			return checked.Int{}, err
+		}
+		if presses == -1 {
+			skipped = append(skipped, strconv.Itoa(i+1))
+		} else {
+			total = total.Add(checked.New(presses))
+		}
+		if tr != nil {
+			tr.Step("machine", "machine", i+1, "presses", presses, "total", total)
+		}
+	}
+	if len(skipped) > 0 {
+		aoc.Warnf(ctx, "skipped %d of %d machines with no solution: %s",
+			len(skipped), len(machines), strings.Join(skipped, ", "))
+	}
+	return total, nil
+}
+
+func init() {
+	aoc.Register(10, aoc.Puzzle[[]Machine]{
+		ParseFunc: Parse,
+		Part1Func: func(ctx context.Context, machines []Machine) (any, error) { return Part1(ctx, machines) },
+		Part2Func: func(ctx context.Context, machines []Machine) (any, error) { return Part2(ctx, machines) },
+	})
+}
@@ -0,0 +1,116 @@
+package day10
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+func TestAnswers(t *testing.T) {
+	aoctest.Check(t, 10)
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.CheckGenerator(t, 10)
+}
+
+// Lines missing a bracket used to panic with a slice out of range.
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name, input  string
+		line, column int
+	}{
+		{"missing [", "#.] (0) {1}\n", 1, 1},
+		{"missing ]", "[#. (0) {1}\n", 1, 1},
+		{"] before [", "]#.[ (0) {1}\n", 1, 4},
+		{"missing )", "[#.] (0 {1}\n", 1, 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			perr := aoctest.StrictParseError(t, 10, tt.input)
+			if perr.Line != tt.line || perr.Column != tt.column {
+				t.Errorf("error at %d:%d, want %d:%d: %v", perr.Line, perr.Column, tt.line, tt.column, perr)
+			}
+		})
+	}
+}
+
+// recorder is a Progress keeping every event.
+type recorder struct {
+	mu     sync.Mutex
+	events []aoc.Event
+}
+
+func (r *recorder) Report(e aoc.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+}
+
+func TestProgress(t *testing.T) {
+	var rec recorder
+	if _, err := aoc.Solve(context.Background(), 10, "example.txt", aoc.Options{Progress: &rec}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Every part reports each of the three example machines, then is done.
+	var want []aoc.Event
+	for part := 1; part <= 2; part++ {
+		for done := range 3 {
+			want = append(want, aoc.Event{Day: 10, Part: part, Phase: "configuring machines", Done: done, Total: 3})
+		}
+		want = append(want, aoc.Event{Day: 10, Part: part, Phase: aoc.PhaseDone})
+	}
+	if len(rec.events) != len(want) {
+		t.Fatalf("got %d events, want %d: %v", len(rec.events), len(want), rec.events)
+	}
+	for i := range want {
+		if rec.events[i] != want[i] {
+			t.Errorf("event %d = %+v, want %+v", i, rec.events[i], want[i])
+		}
+	}
+}
+
+func TestSkippedMachines(t *testing.T) {
+	// The second machine can light neither its first light nor its first
+	// counter, so both parts leave it out and say so.
+	filename := filepath.Join(t.TempDir(), "input.txt")
+	input := "[.##.] (3) (1,3) (2) (2,3) (0,2) (0,1) {3,5,4,7}\n[#.] (1) {1,0}\n"
+	if err := os.WriteFile(filename, []byte(input), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := aoc.Solve(context.Background(), 10, filename, aoc.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"skipped 1 of 2 machines with no solution: 2"}
+	for _, res := range results {
+		if !slices.Equal(res.Diagnostics, want) {
+			t.Errorf("part %d diagnostics = %q, want %q", res.Part, res.Diagnostics, want)
+		}
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	aoctest.Fuzz(f, 10)
+}
+
+func FuzzParseLine(f *testing.F) {
+	// Lines missing their "[" or "]", or with them the wrong way round,
+	// used to slice out of range.
+	f.Add("#.] (0) {1}")
+	f.Add("[#. (0) {1}")
+	f.Add("]#.[ (0) {1}")
+	aoctest.FuzzLine(f, "example.txt", ParseLine)
+}
+
+func BenchmarkInput(b *testing.B) {
+	aoctest.Benchmark(b, 10)
+}
@@ -0,0 +1,78 @@
+package day10
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+// generate writes machines that can always be configured: the target
+// lights and joltages are what pressing random buttons produces.
+func generate(w io.Writer, rng *rand.Rand, size int, p aoc.Params) error {
+	if err := p.Positive("lights", "buttons", "presses"); err != nil {
+		return err
+	}
+	for range size {
+		lights := 1 + rng.IntN(p["lights"])
+		buttons := make([][]int, 1+rng.IntN(p["buttons"]))
+		for i := range buttons {
+			for light := range lights {
+				if rng.IntN(2) == 0 {
+					buttons[i] = append(buttons[i], light)
+				}
+			}
+			if len(buttons[i]) == 0 {
+				buttons[i] = []int{rng.IntN(lights)}
+			}
+		}
+
+		target := []byte(strings.Repeat(".", lights))
+		joltages := make([]int, lights)
+		for _, button := range buttons {
+			toggle := rng.IntN(2) == 1
+			presses := rng.IntN(p["presses"] + 1)
+			for _, light := range button {
+				if toggle {
+					target[light] ^= '.' ^ '#'
+				}
+				joltages[light] += presses
+			}
+		}
+
+		var b strings.Builder
+		fmt.Fprintf(&b, "[%s]", target)
+		for _, button := range buttons {
+			fmt.Fprintf(&b, " (%s)", joinInts(button))
+		}
+		fmt.Fprintf(&b, " {%s}\n", joinInts(joltages))
+		if _, err := io.WriteString(w, b.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func joinInts(ns []int) string {
+	parts := make([]string, len(ns))
+	for i, n := range ns {
+		parts[i] = strconv.Itoa(n)
+	}
+	return strings.Join(parts, ",")
+}
+
+func init() {
+	aoc.RegisterGenerator(10, aoc.Generator{
+		Size:        "machines",
+		DefaultSize: 165,
+		Params: []aoc.Param{
+			{Name: "lights", Default: 10, Usage: "most indicator lights of a machine"},
+			{Name: "buttons", Default: 13, Usage: "most buttons of a machine"},
+			{Name: "presses", Default: 30, Usage: "most presses of a button towards the joltages"},
+		},
+		Func: generate,
+	})
+}
@@ -0,0 +1,109 @@
+package day10
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+// checkMachine rejects machines whose buttons reach past their lights or
+// counters.
+func checkMachine(m Machine) error {
+	if len(m.Joltages) != len(m.Target) {
+		return errors.New("machine has a joltage per light missing")
+	}
+	for _, button := range m.Buttons {
+		for _, light := range button {
+			if light < 0 || light >= len(m.Target) {
+				return errors.New("button wired to a missing light")
+			}
+		}
+	}
+	return nil
+}
+
+// fewestToggles searches breadth first through the light patterns reachable
+// by pressing buttons, returning the presses to reach the target or -1.
+func fewestToggles(m Machine) int {
+	target := 0
+	for i, on := range m.Target {
+		target |= on << i
+	}
+	presses := map[int]int{0: 0}
+	for queue := []int{0}; len(queue) > 0; queue = queue[1:] {
+		lights := queue[0]
+		if lights == target {
+			return presses[lights]
+		}
+		for _, button := range m.Buttons {
+			next := lights
+			for _, light := range button {
+				next ^= 1 << light
+			}
+			if _, seen := presses[next]; !seen {
+				presses[next] = presses[lights] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+	return -1
+}
+
+// fewestIncrements searches breadth first through the joltage levels
+// reachable by pressing buttons without overshooting any target, returning
+// the presses to reach the targets or -1.
+func fewestIncrements(m Machine) int {
+	key := func(levels []int) string { return fmt.Sprint(levels) }
+	start := make([]int, len(m.Joltages))
+	presses := map[string]int{key(start): 0}
+	for queue := [][]int{start}; len(queue) > 0; queue = queue[1:] {
+		levels := queue[0]
+		if slices.Equal(levels, m.Joltages) {
+			return presses[key(levels)]
+		}
+	next:
+		for _, button := range m.Buttons {
+			raised := slices.Clone(levels)
+			for _, counter := range button {
+				if raised[counter]++; raised[counter] > m.Joltages[counter] {
+					continue next
+				}
+			}
+			if _, seen := presses[key(raised)]; !seen {
+				presses[key(raised)] = presses[key(levels)] + 1
+				queue = append(queue, raised)
+			}
+		}
+	}
+	return -1
+}
+
+// sumFewest adds up the presses of every machine, skipping the ones that
+// cannot be configured like the solver does.
+func sumFewest(machines []Machine, fewest func(Machine) int) (int, error) {
+	total := 0
+	for _, m := range machines {
+		if err := checkMachine(m); err != nil {
+			return 0, err
+		}
+		if n := fewest(m); n >= 0 {
+			total += n
+		}
+	}
+	return total, nil
+}
+
+func TestReference(t *testing.T) {
+	aoctest.Differential(t, 10, aoc.Puzzle[[]Machine]{
+		ParseFunc: Parse,
+		Part1Func: func(_ context.Context, machines []Machine) (any, error) { return sumFewest(machines, fewestToggles) },
+		Part2Func: func(_ context.Context, machines []Machine) (any, error) {
+			return sumFewest(machines, fewestIncrements)
+		},
+	}, aoctest.Inputs{Size: 6, Params: aoc.Params{"lights": 4, "buttons": 5, "presses": 3}})
+}
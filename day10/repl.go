@@ -0,0 +1,50 @@
+package day10
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterCommands(10, aoc.Command[[]Machine]{
+		Name:  "machine",
+		Args:  "<n> | <line>",
+		Usage: "solve the nth machine, or one given as a line of input, in both parts",
+		Func: func(ctx context.Context, w io.Writer, machines []Machine, args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("want a machine number or line")
+			}
+			var machine Machine
+			if _, err := strconv.Atoi(args[0]); err == nil && len(args) == 1 {
+				i, err := aoc.Nth(args[0], len(machines))
+				if err != nil {
+					return err
+				}
+				machine = machines[i]
+			} else {
+				var err error
+				if machine, err = ParseLine(strings.Join(args, " ")); err != nil {
+					return err
+				}
+			}
+
+			for part, solve := range []func(context.Context, Machine) (int, error){solvePart1, solvePart2} {
+				presses, err := solve(ctx, machine)
+				if err != nil {
+					return err
+				}
+				if presses == -1 {
+					fmt.Fprintf(w, "part %d: no solution\n", part+1)
+				} else {
+					fmt.Fprintf(w, "part %d: fewest presses %d\n", part+1, presses)
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,11 @@
+// Command day11 solves day 11 against input.txt in the working directory.
+package main
+
+import (
+	"github.com/janneh/advent-of-code-2025/aoc"
+	_ "github.com/janneh/advent-of-code-2025/day11"
+)
+
+func main() {
+	aoc.Main(11)
+}
@@ -0,0 +1,167 @@
+// Package day11 solves day 11 of Advent of Code 2025.
+package day11
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/checked"
+)
+
+// Graph maps each device to the devices its outputs connect to.
+type Graph map[string][]string
+
+// Parse reads one "device: outputs..." line per device.
+func Parse(r io.Reader, diag *aoc.Diagnostics) (Graph, error) {
+	graph := make(Graph)
+	scanner := aoc.NewLineScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		parts := strings.Split(line, ": ")
+		if len(parts) != 2 || parts[0] == "" {
+			if err := diag.Errorf(scanner.Line, 1, line, "want \"device: outputs\""); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		from := parts[0]
+		if _, dup := graph[from]; dup {
+			if err := diag.Errorf(scanner.Line, 1, line, "device %s listed twice", from); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		outputs := strings.Fields(parts[1])
+		graph[from] = outputs
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return graph, nil
+}
+
+// Part1 counts all paths from "you" to "out".
+func Part1(ctx context.Context, graph Graph) (checked.Int, error) {
+	visited := make(map[string]bool)
+	return countPaths(ctx, graph, "you", "out", visited, aoc.Tracing(ctx))
+}
+
+// Part2 counts paths from "svr" to "out" that visit both "dac" and "fft".
+func Part2(ctx context.Context, graph Graph) (checked.Int, error) {
+	visited := make(map[string]bool)
+	memo := make(map[State]checked.Int)
+	return countPathsWithRequiredMemo(ctx, graph, "svr", "out", visited, false, false, memo, aoc.Tracing(ctx))
+}
+
+func init() {
+	aoc.Register(11, aoc.Puzzle[Graph]{
+		ParseFunc: Parse,
+		Part1Func: func(ctx context.Context, graph Graph) (any, error) { return Part1(ctx, graph) },
+		Part2Func: func(ctx context.Context, graph Graph) (any, error) { return Part2(ctx, graph) },
+	})
+}
+
+type State struct {
+	node    string
+	seenDAC bool
+	seenFFT bool
+}
+
+// countPaths counts the paths from current to target that avoid visited,
+// stopping with ctx's error once ctx is done.
+func countPaths(ctx context.Context, graph map[string][]string, current, target string, visited map[string]bool, tr *aoc.StepTracer) (checked.Int, error) {
+	if current == target {
+		return checked.New(1), nil
+	}
+
+	if visited[current] {
+		return checked.Int{}, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return checked.Int{}, err
+	}
+
+	visited[current] = true
+	defer func() {
+		visited[current] = false
+	}()
+
+	var totalPaths checked.Int
+	for _, neighbor := range graph[current] {
+		paths, err := countPaths(ctx, graph, neighbor, target, visited, tr)
+		if err != nil {
+			return checked.Int{}, err
+		}
+		totalPaths = totalPaths.Add(paths)
+	}
+	if tr != nil {
+		tr.Step("device", "device", current, "paths", totalPaths)
+	}
+
+	return totalPaths, nil
+}
+
+// countPathsWithRequiredMemo counts the paths from current to target that
+// pass both "dac" and "fft", stopping with ctx's error once ctx is done.
+func countPathsWithRequiredMemo(ctx context.Context, graph map[string][]string, current, target string,
+	visited map[string]bool, seenDAC, seenFFT bool, memo map[State]checked.Int, tr *aoc.StepTracer) (checked.Int, error) {
+
+	if current == "dac" {
+		seenDAC = true
+	}
+
+	if current == "fft" {
+		seenFFT = true
+	}
+
+	if current == target {
+		if seenDAC && seenFFT {
+			return checked.New(1), nil
+		}
+		return checked.Int{}, nil
+	}
+
+	if visited[current] {
+		return checked.Int{}, nil
+	}
+
+	state := State{current, seenDAC, seenFFT}
+	if val, ok := memo[state]; ok {
+		return val, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return checked.Int{}, err
+	}
+
+	visited[current] = true
+	defer func() {
+		visited[current] = false
+	}()
+
+	var totalPaths checked.Int
+	for _, neighbor := range graph[current] {
+		paths, err := countPathsWithRequiredMemo(ctx, graph, neighbor, target, visited, seenDAC, seenFFT, memo, tr)
+		if err != nil {
+			return checked.Int{}, err
+		}
+		totalPaths = totalPaths.Add(paths)
+	}
+
+	memo[state] = totalPaths
+	if tr != nil {
+		tr.Step("device", "device", current, "dac", seenDAC, "fft", seenFFT, "paths", totalPaths)
+	}
+
+	return totalPaths, nil
+}
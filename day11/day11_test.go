@@ -0,0 +1,59 @@
+package day11
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+func TestAnswers(t *testing.T) {
+	aoctest.Check(t, 11)
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.CheckGenerator(t, 11)
+}
+
+func TestCanceled(t *testing.T) {
+	f, err := os.Open("example2.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	graph, err := Parse(f, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := Part1(ctx, graph); !errors.Is(err, context.Canceled) {
+		t.Errorf("Part1 with canceled context: err = %v, want %v", err, context.Canceled)
+	}
+	if _, err := Part2(ctx, graph); !errors.Is(err, context.Canceled) {
+		t.Errorf("Part2 with canceled context: err = %v, want %v", err, context.Canceled)
+	}
+
+	// The runner reports a canceled part as such rather than as timed out.
+	results, err := aoc.Solve(ctx, 11, "example2.txt", aoc.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, res := range results {
+		if res.Err != "canceled" || res.TimedOut {
+			t.Errorf("part %d: err = %q, timed out %v, want canceled", res.Part, res.Err, res.TimedOut)
+		}
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	aoctest.Fuzz(f, 11)
+}
+
+func BenchmarkInput(b *testing.B) {
+	aoctest.Benchmark(b, 11)
+}
@@ -0,0 +1,60 @@
+package day11
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+// generate writes an acyclic graph of size devices besides the named ones.
+// Devices are put in a random order with svr and you first and out last,
+// and every device but out connects to a few devices after it.
+func generate(w io.Writer, rng *rand.Rand, size int, p aoc.Params) error {
+	if err := p.Positive("outputs"); err != nil {
+		return err
+	}
+
+	names := map[string]bool{"svr": true, "you": true, "dac": true, "fft": true, "out": true}
+	middle := []string{"dac", "fft"}
+	for len(middle) < size+2 {
+		name := string([]byte{byte('a' + rng.IntN(26)), byte('a' + rng.IntN(26)), byte('a' + rng.IntN(26))})
+		if !names[name] {
+			names[name] = true
+			middle = append(middle, name)
+		}
+	}
+	rng.Shuffle(len(middle), func(i, j int) { middle[i], middle[j] = middle[j], middle[i] })
+	order := append(append([]string{"svr", "you"}, middle...), "out")
+
+	for i, from := range order[:len(order)-1] {
+		later := order[i+1:]
+		outputs := make(map[string]bool)
+		var list []string
+		for range 1 + rng.IntN(p["outputs"]) {
+			// Favour close devices so paths stay long
+			to := later[min(len(later)-1, rng.IntN(min(len(later), 8)))]
+			if !outputs[to] {
+				outputs[to] = true
+				list = append(list, to)
+			}
+		}
+		if _, err := fmt.Fprintf(w, "%s: %s\n", from, strings.Join(list, " ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func init() {
+	aoc.RegisterGenerator(11, aoc.Generator{
+		Size:        "devices",
+		DefaultSize: 60,
+		Params: []aoc.Param{
+			{Name: "outputs", Default: 3, Usage: "most outputs of a device"},
+		},
+		Func: generate,
+	})
+}
@@ -0,0 +1,54 @@
+package day11
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+// errCycle rejects graphs with a loop, where the puzzle's paths are not
+// well defined.
+var errCycle = errors.New("devices are wired in a loop")
+
+// paths follows every path from the last device of path to "out" one at a
+// time, calling visit with each complete one.
+func paths(graph Graph, path []string, visit func([]string)) error {
+	device := path[len(path)-1]
+	if device == "out" {
+		visit(path)
+		return nil
+	}
+	for _, next := range graph[device] {
+		if slices.Contains(path, next) {
+			return errCycle
+		}
+		if err := paths(graph, append(path, next), visit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestReference(t *testing.T) {
+	aoctest.Differential(t, 11, aoc.Puzzle[Graph]{
+		ParseFunc: Parse,
+		Part1Func: func(_ context.Context, graph Graph) (any, error) {
+			count := 0
+			err := paths(graph, []string{"you"}, func([]string) { count++ })
+			return count, err
+		},
+		Part2Func: func(_ context.Context, graph Graph) (any, error) {
+			count := 0
+			err := paths(graph, []string{"svr"}, func(path []string) {
+				if slices.Contains(path, "dac") && slices.Contains(path, "fft") {
+					count++
+				}
+			})
+			return count, err
+		},
+	}, aoctest.Inputs{Size: 10, Params: aoc.Params{"outputs": 3}})
+}
@@ -0,0 +1,49 @@
+package day11
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/checked"
+)
+
+func init() {
+	aoc.RegisterCommands(11, aoc.Command[Graph]{
+		Name:  "paths",
+		Args:  "<from> <to>",
+		Usage: "count the paths between two devices",
+		Func: func(_ context.Context, w io.Writer, graph Graph, args []string) error {
+			if err := aoc.ArgCount(args, 2); err != nil {
+				return err
+			}
+			from, to := args[0], args[1]
+			for _, device := range []string{from, to} {
+				if _, ok := graph[device]; !ok && device != "out" {
+					return fmt.Errorf("no device %q", device)
+				}
+			}
+			fmt.Fprintf(w, "%v paths from %s to %s\n", routes(graph, from, to, make(map[string]checked.Int)), from, to)
+			return nil
+		},
+	})
+}
+
+// routes counts the paths from current to target, remembering the count of
+// every device in memo as the rack has no loops.
+func routes(graph Graph, current, target string, memo map[string]checked.Int) checked.Int {
+	if current == target {
+		return checked.New(1)
+	}
+	if n, ok := memo[current]; ok {
+		return n
+	}
+	memo[current] = checked.Int{} // a loop adds no paths
+	var n checked.Int
+	for _, next := range graph[current] {
+		n = n.Add(routes(graph, next, target, memo))
+	}
+	memo[current] = n
+	return n
+}
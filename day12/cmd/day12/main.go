@@ -0,0 +1,11 @@
+// Command day12 solves day 12 against input.txt in the working directory.
+package main
+
+import (
+	"github.com/janneh/advent-of-code-2025/aoc"
+	_ "github.com/janneh/advent-of-code-2025/day12"
+)
+
+func main() {
+	aoc.Main(12)
+}
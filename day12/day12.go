@@ -0,0 +1,355 @@
+// Package day12 solves day 12 of Advent of Code 2025.
+package day12
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/grid"
+)
+
+// Shape is a present outline; true cells are part of the present.
+type Shape = grid.Grid[bool]
+
+// Region is the area under a tree and how many of each shape it must hold.
+type Region struct {
+	Width, Height int
+	Counts        []int
+}
+
+// Input holds the present shapes and the regions under the trees.
+type Input struct {
+	Shapes  []*Shape
+	Regions []Region
+}
+
+// Part1 counts the regions that can fit all of their presents.
+func Part1(ctx context.Context, input Input) (int, error) {
+	shapes, regions := input.Shapes, input.Regions
+
+	allVariants := make([][]*Shape, len(shapes))
+	for i, shape := range shapes {
+		allVariants[i] = GenerateVariants(shape)
+	}
+
+	tr := aoc.Tracing(ctx)
+	validRegions := 0
+	for i, region := range regions {
+		ok, err := CanFitPresents(ctx, region, allVariants)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			validRegions++
+		}
+		if tr != nil {
+			tr.Step("region", "region", i+1, "size", fmt.Sprintf("%dx%d", region.Width, region.Height), "counts", region.Counts, "fits", ok)
+		}
+	}
+
+	return validRegions, nil
+}
+
+// Parse reads the present shapes followed by the regions.
+func Parse(r io.Reader, diag *aoc.Diagnostics) (Input, error) {
+	scanner := aoc.NewLineScanner(r)
+	shapes := []*Shape{}
+	regions := []Region{}
+
+	addRegion := func(line string) error {
+		region, err := parseRegion(line)
+		if err != nil {
+			perr := err.(*aoc.ParseError)
+			perr.Line = scanner.Line
+			return diag.Report(perr)
+		}
+		for i, count := range region.Counts {
+			if count > 0 && i >= len(shapes) {
+				return diag.Errorf(scanner.Line, 0, line, "region needs %d of shape %d, which is not defined", count, i)
+			}
+		}
+		regions = append(regions, region)
+		return nil
+	}
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		if strings.HasSuffix(line, ":") {
+			shape := [][]bool{}
+			for scanner.Scan() {
+				line := strings.TrimSpace(scanner.Text())
+				if line == "" {
+					break
+				}
+				if strings.Contains(line, "x") && strings.Contains(line, ":") {
+					if err := addRegion(line); err != nil {
+						return Input{}, err
+					}
+					goto parseRegions
+				}
+				if i := strings.IndexFunc(line, func(c rune) bool { return c != '#' && c != '.' }); i != -1 {
+					if err := diag.Errorf(scanner.Line, i+1, line, "invalid shape cell %q", line[i]); err != nil {
+						return Input{}, err
+					}
+					continue
+				}
+				if len(shape) > 0 && len(line) != len(shape[0]) {
+					if err := diag.Errorf(scanner.Line, 1, line, "shape row has %d cells, want %d", len(line), len(shape[0])); err != nil {
+						return Input{}, err
+					}
+					continue
+				}
+				row := []bool{}
+				for _, c := range line {
+					row = append(row, c == '#')
+				}
+				shape = append(shape, row)
+			}
+			if len(shape) > 0 {
+				g, err := grid.FromRows(shape)
+				if err != nil {
+					return Input{}, err
+				}
+				shapes = append(shapes, g)
+			}
+		} else if strings.Contains(line, "x") && strings.Contains(line, ":") {
+			if err := addRegion(line); err != nil {
+				return Input{}, err
+			}
+		} else if err := diag.Errorf(scanner.Line, 1, line, "expected a shape or a region"); err != nil {
+			return Input{}, err
+		}
+	}
+
+parseRegions:
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if strings.Contains(line, "x") && strings.Contains(line, ":") {
+			if err := addRegion(line); err != nil {
+				return Input{}, err
+			}
+		} else if err := diag.Errorf(scanner.Line, 1, line, "expected a region"); err != nil {
+			return Input{}, err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Input{}, err
+	}
+
+	return Input{shapes, regions}, nil
+}
+
+// parseRegion parses a "WxH: counts..." line. Errors are *aoc.ParseError
+// values carrying the column of the problem.
+func parseRegion(line string) (Region, error) {
+	fail := func(col int, format string, args ...any) (Region, error) {
+		return Region{}, &aoc.ParseError{Column: col + 1, Text: line, Err: fmt.Errorf(format, args...)}
+	}
+
+	parts := strings.Split(line, ": ")
+	if len(parts) != 2 {
+		return fail(0, "want \"WxH: counts\"")
+	}
+
+	dims := strings.Split(parts[0], "x")
+	if len(dims) != 2 {
+		return fail(0, "invalid region size %q", parts[0])
+	}
+	width, err := strconv.Atoi(dims[0])
+	if err != nil || width <= 0 {
+		return fail(0, "invalid region width %q", dims[0])
+	}
+	height, err := strconv.Atoi(dims[1])
+	if err != nil || height <= 0 {
+		return fail(len(dims[0])+1, "invalid region height %q", dims[1])
+	}
+
+	countStrs := strings.Fields(parts[1])
+	counts := []int{}
+	for _, cs := range countStrs {
+		c, err := strconv.Atoi(cs)
+		if err != nil || c < 0 {
+			return fail(len(parts[0])+2+strings.Index(parts[1], cs), "invalid present count %q", cs)
+		}
+		counts = append(counts, c)
+	}
+
+	return Region{width, height, counts}, nil
+}
+
+// GenerateVariants returns the distinct rotations and reflections of shape,
+// trimmed to its filled cells.
+func GenerateVariants(shape *Shape) []*Shape {
+	variants := []*Shape{}
+	current := trim(shape)
+
+	for r := 0; r < 4; r++ {
+		for _, v := range []*Shape{current, current.FlipH()} {
+			if !slices.ContainsFunc(variants, func(u *Shape) bool { return grid.Equal(u, v) }) {
+				variants = append(variants, v)
+			}
+		}
+		current = current.RotateCW()
+	}
+
+	return variants
+}
+
+// trim crops shape to the rows and columns holding a filled cell, as empty
+// ones along its edge take no room in a region.
+func trim(shape *Shape) *Shape {
+	top, left, bottom, right := shape.Rows, shape.Cols, -1, -1
+	for p, filled := range shape.All() {
+		if filled {
+			top, left = min(top, p.Row), min(left, p.Col)
+			bottom, right = max(bottom, p.Row), max(right, p.Col)
+		}
+	}
+	if bottom < 0 {
+		return grid.New[bool](0, 0)
+	}
+
+	trimmed := grid.New[bool](bottom-top+1, right-left+1)
+	for p := range trimmed.All() {
+		trimmed.Set(p, shape.At(p.Add(grid.Point{Row: top, Col: left})))
+	}
+	return trimmed
+}
+
+func shapeSize(shape *Shape) int {
+	return shape.CountFunc(func(filled bool) bool { return filled })
+}
+
+// CanFitPresents reports whether the presents listed for region can all be
+// placed without overlapping, given the variants of every shape. It fails
+// for a region needing a shape without variants, and the search stops with
+// ctx's error once ctx is done.
+func CanFitPresents(ctx context.Context, region Region, allVariants [][]*Shape) (bool, error) {
+	g := grid.New[int](region.Height, region.Width)
+
+	type Present struct {
+		shapeIdx int
+		size     int
+	}
+	presents := []Present{}
+	for shapeIdx, count := range region.Counts {
+		if count == 0 {
+			continue
+		}
+		if shapeIdx >= len(allVariants) || len(allVariants[shapeIdx]) == 0 {
+			return false, fmt.Errorf("region needs shape %d, which has no variants", shapeIdx)
+		}
+		size := shapeSize(allVariants[shapeIdx][0])
+		for i := 0; i < count; i++ {
+			presents = append(presents, Present{shapeIdx, size})
+		}
+	}
+
+	for i := 0; i < len(presents)-1; i++ {
+		for j := i + 1; j < len(presents); j++ {
+			if presents[j].size > presents[i].size {
+				presents[i], presents[j] = presents[j], presents[i]
+			}
+		}
+	}
+
+	shapeIndices := make([]int, len(presents))
+	for i, p := range presents {
+		shapeIndices[i] = p.shapeIdx
+	}
+
+	return tryPlace(ctx, g, shapeIndices, allVariants, 0)
+}
+
+func tryPlace(ctx context.Context, g *grid.Grid[int], presents []int, allVariants [][]*Shape, presentIdx int) (bool, error) {
+	if presentIdx >= len(presents) {
+		return true, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	remainingArea := g.CountFunc(func(id int) bool { return id == 0 })
+
+	neededArea := 0
+	for i := presentIdx; i < len(presents); i++ {
+		shapeIdx := presents[i]
+		if shapeIdx < len(allVariants) && len(allVariants[shapeIdx]) > 0 {
+			neededArea += shapeSize(allVariants[shapeIdx][0])
+		}
+	}
+
+	if neededArea > remainingArea {
+		return false, nil
+	}
+
+	shapeIdx := presents[presentIdx]
+	variants := allVariants[shapeIdx]
+
+	for _, variant := range variants {
+		if variant.Rows == 0 || variant.Cols == 0 {
+			continue
+		}
+		for r := 0; r <= g.Rows-variant.Rows; r++ {
+			for c := 0; c <= g.Cols-variant.Cols; c++ {
+				at := grid.Point{Row: r, Col: c}
+				if canPlace(g, variant, at) {
+					place(g, variant, at, presentIdx+1)
+					ok, err := tryPlace(ctx, g, presents, allVariants, presentIdx+1)
+					if ok || err != nil {
+						return ok, err
+					}
+					place(g, variant, at, 0)
+				}
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// canPlace reports whether shape fits on g with its top left corner at at.
+func canPlace(g *grid.Grid[int], shape *Shape, at grid.Point) bool {
+	for r := range shape.Rows {
+		row := g.Row(at.Row + r)[at.Col:]
+		for c, filled := range shape.Row(r) {
+			if filled && row[c] != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// place marks the cells covered by shape with its top left corner at at
+// with id, or clears them for id 0.
+func place(g *grid.Grid[int], shape *Shape, at grid.Point, id int) {
+	for r := range shape.Rows {
+		row := g.Row(at.Row + r)[at.Col:]
+		for c, filled := range shape.Row(r) {
+			if filled {
+				row[c] = id
+			}
+		}
+	}
+}
+
+func init() {
+	aoc.Register(12, aoc.Puzzle[Input]{
+		ParseFunc: Parse,
+		Part1Func: func(ctx context.Context, input Input) (any, error) { return Part1(ctx, input) },
+	})
+}
@@ -0,0 +1,77 @@
+package day12
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+func TestAnswers(t *testing.T) {
+	aoctest.Check(t, 12)
+}
+
+// Region sizes without an "x" used to panic indexing dims[1], and counts
+// of shapes that were never defined used to be dropped silently.
+func TestParseErrors(t *testing.T) {
+	const shape = "0:\n###\n#..\n###\n\n"
+	tests := []struct {
+		name, input  string
+		line, column int
+	}{
+		{"no x", shape + "4: 1\n", 6, 1},
+		{"no height", shape + "4x: 1\n", 6, 3},
+		{"no width", shape + "x4: 1\n", 6, 1},
+		{"count", shape + "4x4: one\n", 6, 6},
+		{"undefined shape", shape + "4x4: 1 2\n", 6, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			perr := aoctest.StrictParseError(t, 12, tt.input)
+			if perr.Line != tt.line || perr.Column != tt.column {
+				t.Errorf("error at %d:%d, want %d:%d: %v", perr.Line, perr.Column, tt.line, tt.column, perr)
+			}
+		})
+	}
+}
+
+func TestPart1Canceled(t *testing.T) {
+	f, err := os.Open("example.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	input, err := Parse(f, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := Part1(ctx, input); !errors.Is(err, context.Canceled) {
+		t.Errorf("Part1 with canceled context: err = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.CheckGenerator(t, 12)
+}
+
+func FuzzParse(f *testing.F) {
+	aoctest.Fuzz(f, 12)
+}
+
+func FuzzParseRegion(f *testing.F) {
+	// Sizes without an "x", or with nothing on one side of it, used to
+	// read dims[1] out of range.
+	f.Add("4: 1")
+	f.Add("4x: 1")
+	f.Add("x4: 1")
+	aoctest.FuzzLine(f, "example.txt", parseRegion)
+}
+
+func BenchmarkInput(b *testing.B) {
+	aoctest.Benchmark(b, 12)
+}
@@ -0,0 +1,68 @@
+package day12
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+// generate writes random 3x3 present shapes followed by size regions whose
+// presents cover at most about fill percent of their area.
+func generate(w io.Writer, rng *rand.Rand, size int, p aoc.Params) error {
+	if err := p.Positive("shapes", "side", "fill"); err != nil {
+		return err
+	}
+	if p["side"] < 3 {
+		return fmt.Errorf("parameter side must be at least 3, got %d", p["side"])
+	}
+
+	areas := make([]int, p["shapes"])
+	for i := range areas {
+		fmt.Fprintf(w, "%d:\n", i)
+		cells := []byte("#########")
+		for range rng.IntN(5) {
+			cells[rng.IntN(len(cells))] = '.'
+		}
+		cells[4] = '#'
+		areas[i] = strings.Count(string(cells), "#")
+		fmt.Fprintf(w, "%s\n%s\n%s\n\n", cells[0:3], cells[3:6], cells[6:9])
+	}
+
+	for range size {
+		width, height := 3+rng.IntN(p["side"]-2), 3+rng.IntN(p["side"]-2)
+		budget := width * height * p["fill"] / 100
+		counts := make([]string, len(areas))
+		for i := range counts {
+			counts[i] = "0"
+		}
+		for n := make([]int, len(areas)); ; {
+			i := rng.IntN(len(areas))
+			if budget < areas[i] {
+				break
+			}
+			budget -= areas[i]
+			n[i]++
+			counts[i] = fmt.Sprint(n[i])
+		}
+		if _, err := fmt.Fprintf(w, "%dx%d: %s\n", width, height, strings.Join(counts, " ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func init() {
+	aoc.RegisterGenerator(12, aoc.Generator{
+		Size:        "regions",
+		DefaultSize: 1000,
+		Params: []aoc.Param{
+			{Name: "shapes", Default: 6, Usage: "number of present shapes"},
+			{Name: "side", Default: 50, Usage: "longest side of a region"},
+			{Name: "fill", Default: 70, Usage: "percentage of a region's area the presents may cover"},
+		},
+		Func: generate,
+	})
+}
@@ -0,0 +1,142 @@
+package day12
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+	"github.com/janneh/advent-of-code-2025/aoc/aoctest"
+)
+
+// cell is a row and column offset within a present.
+type cell struct{ r, c int }
+
+// orientations returns the cells of every distinct rotation and reflection
+// of shape, each sorted in reading order and shifted so its first cell is
+// at the origin.
+func orientations(shape *Shape) [][]cell {
+	var base []cell
+	for r := range shape.Rows {
+		for c, filled := range shape.Row(r) {
+			if filled {
+				base = append(base, cell{r, c})
+			}
+		}
+	}
+
+	var all [][]cell
+	for _, flip := range []bool{false, true} {
+		cells := slices.Clone(base)
+		for range 4 {
+			for i, x := range cells {
+				cells[i] = cell{x.c, -x.r}
+			}
+			o := make([]cell, len(cells))
+			for i, x := range cells {
+				o[i] = x
+				if flip {
+					o[i].c = -x.c
+				}
+			}
+			slices.SortFunc(o, func(a, b cell) int {
+				if a.r != b.r {
+					return a.r - b.r
+				}
+				return a.c - b.c
+			})
+			for i := len(o) - 1; i >= 0; i-- {
+				o[i] = cell{o[i].r - o[0].r, o[i].c - o[0].c}
+			}
+			if !slices.ContainsFunc(all, func(u []cell) bool { return slices.Equal(u, o) }) {
+				all = append(all, o)
+			}
+		}
+	}
+	return all
+}
+
+// fits fills the region one cell at a time in reading order: the first
+// cell not yet decided is either left empty, while the region has room to
+// spare, or covered by the first cell of some remaining present.
+func fits(taken []bool, width, spare int, left []int, presents [][][]cell) bool {
+	if !slices.ContainsFunc(left, func(n int) bool { return n > 0 }) {
+		return true
+	}
+	next := slices.Index(taken, false)
+	if next == -1 {
+		return false
+	}
+	r, c := next/width, next%width
+	height := len(taken) / width
+
+	for i, count := range left {
+		if count == 0 {
+			continue
+		}
+	orientation:
+		for _, o := range presents[i] {
+			for _, x := range o {
+				if rr, cc := r+x.r, c+x.c; rr >= height || cc < 0 || cc >= width || taken[rr*width+cc] {
+					continue orientation
+				}
+			}
+			for _, x := range o {
+				taken[(r+x.r)*width+c+x.c] = true
+			}
+			left[i]--
+			ok := fits(taken, width, spare, left, presents)
+			left[i]++
+			for _, x := range o {
+				taken[(r+x.r)*width+c+x.c] = false
+			}
+			if ok {
+				return true
+			}
+		}
+	}
+
+	if spare == 0 {
+		return false
+	}
+	taken[next] = true
+	ok := fits(taken, width, spare-1, left, presents)
+	taken[next] = false
+	return ok
+}
+
+func countFitting(input Input) (int, error) {
+	presents := make([][][]cell, len(input.Shapes))
+	sizes := make([]int, len(input.Shapes))
+	for i, shape := range input.Shapes {
+		presents[i] = orientations(shape)
+		sizes[i] = len(presents[i][0])
+		if sizes[i] == 0 {
+			return 0, errors.New("present without cells")
+		}
+	}
+
+	count := 0
+	for _, region := range input.Regions {
+		if len(region.Counts) > len(input.Shapes) {
+			return 0, errors.New("region counts presents of unknown shapes")
+		}
+		spare := region.Width * region.Height
+		for i, n := range region.Counts {
+			spare -= n * sizes[i]
+		}
+		taken := make([]bool, region.Width*region.Height)
+		if spare >= 0 && fits(taken, region.Width, spare, slices.Clone(region.Counts), presents) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func TestReference(t *testing.T) {
+	aoctest.Differential(t, 12, aoc.Puzzle[Input]{
+		ParseFunc: Parse,
+		Part1Func: func(_ context.Context, input Input) (any, error) { return countFitting(input) },
+	}, aoctest.Inputs{Size: 8, Params: aoc.Params{"shapes": 3, "side": 6, "fill": 90}})
+}
@@ -0,0 +1,48 @@
+package day12
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/janneh/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterCommands(12, aoc.Command[Input]{
+		Name:  "region",
+		Args:  "<n> | <WxH: counts>",
+		Usage: "tell whether the presents fit the nth region, or one given as a line of input",
+		Func: func(ctx context.Context, w io.Writer, input Input, args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("want a region number or line")
+			}
+			var region Region
+			if _, err := strconv.Atoi(args[0]); err == nil && len(args) == 1 {
+				i, err := aoc.Nth(args[0], len(input.Regions))
+				if err != nil {
+					return err
+				}
+				region = input.Regions[i]
+			} else {
+				var err error
+				if region, err = parseRegion(strings.Join(args, " ")); err != nil {
+					return err
+				}
+			}
+
+			variants := make([][]*Shape, len(input.Shapes))
+			for i, shape := range input.Shapes {
+				variants[i] = GenerateVariants(shape)
+			}
+			fits, err := CanFitPresents(ctx, region, variants)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "%dx%d with presents %v: fits %v\n", region.Width, region.Height, region.Counts, fits)
+			return nil
+		},
+	})
+}
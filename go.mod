@@ -0,0 +1,3 @@
+module github.com/janneh/advent-of-code-2025
+
+go 1.24